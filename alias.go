@@ -181,6 +181,22 @@ const (
 	ChartTypeCandlestick      = "candlestick"
 	ChartTypeViolin           = "violin"
 	ChartTypeHorizontalViolin = "horizontalViolin"
+	ChartTypeBoxPlot          = "boxPlot"
+	ChartTypeWaterfall        = "waterfall"
+	ChartTypeTreemap          = "treemap"
+	ChartTypeSunburst         = "sunburst"
+	ChartTypeSankey           = "sankey"
+	ChartTypeGauge            = "gauge"
+	ChartTypeGantt            = "gantt"
+	ChartTypeCalendarHeatMap  = "calendarHeatMap"
+	ChartTypeParallel         = "parallel"
+	ChartTypeDensity          = "density"
+	ChartTypeRadialBar        = "radialBar"
+	ChartTypeDumbbell         = "dumbbell"
+	ChartTypeSlope            = "slope"
+	ChartTypeRidgeline        = "ridgeline"
+	ChartTypeMarimekko        = "marimekko"
+	ChartTypeDivergingBar     = "divergingBar"
 )
 
 const (
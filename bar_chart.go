@@ -53,6 +53,13 @@ type BarChartOption struct {
 	// This ignores SeriesLabelPosition, and BarMargin unless a second y-axis places bars beside the stack.
 	// Only the first y-axis is stacked, and MarkLine only renders for the first series on it.
 	StackSeries *bool
+	// StackPercent when *true renders series stacked as above, with the values of each category scaled to their
+	// percentage share so each bar sums to 100%. The value axis is fixed to 0-100% and labels default to the share.
+	// Negative values are treated as zero, and error bars are scaled with the values.
+	StackPercent *bool
+	// StackPercentRawLabels when *true with StackPercent renders the original series values in the labels, rather
+	// than the percentage share.
+	StackPercentRawLabels *bool
 	// SeriesLabelPosition specifies the label position for the series.
 	// Vertical bars: "top" or "bottom". Horizontal bars: "left" or "right".
 	SeriesLabelPosition string
@@ -225,8 +232,9 @@ func (b *barChart) renderVerticalBars(result *defaultRenderResult) (Box, error)
 
 	markPointPainter := newMarkPointPainter(seriesPainter)
 	markLinePainter := newMarkLinePainter(seriesPainter)
+	errorBarPainter := newErrorBarPainter(seriesPainter)
 	// render list must start with the markPointPainter, as it can influence label painters (if enabled)
-	rendererList := []renderer{markPointPainter, markLinePainter}
+	rendererList := []renderer{markPointPainter, markLinePainter, errorBarPainter}
 
 	// stacking is limited to the first y-axis, so the bounds may not be the first and last series
 	firstStackedIndex, lastStackedIndex := stackedSeriesBounds(opt.SeriesList)
@@ -250,6 +258,14 @@ func (b *barChart) renderVerticalBars(result *defaultRenderResult) (Box, error)
 			rendererList = append(rendererList, labelPainter)
 		}
 
+		var errorColor Color
+		var errorStrokeWidth, stdDevError float64
+		var capWidth int
+		if series.ErrorBar.isSet() {
+			errorColor, errorStrokeWidth, capWidth = errorBarStyle(series.ErrorBar, seriesColor, barWidth/2)
+			stdDevError = series.ErrorBar.stdDevError(series.Values)
+		}
+
 		points := make([]Point, len(series.Values)) // used for mark points
 		for j, item := range series.Values {
 			if j >= result.categoryAxisRange.divideCount {
@@ -282,6 +298,18 @@ func (b *barChart) renderVerticalBars(result *defaultRenderResult) (Box, error)
 				seriesPainter.FilledRect(x, top, x+barWidth, bottom, seriesColor, seriesColor, 0.0)
 			}
 
+			if low, high, ok := series.ErrorBar.bounds(j, item, stdDevError); ok {
+				// positioned relative to the bar top so stacked bars offset the error range
+				errorBarPainter.add(errorBarRenderOption{
+					center:      x + (barWidth >> 1),
+					low:         top - (yRange.getHeight(low) - h),
+					high:        top - (yRange.getHeight(high) - h),
+					capWidth:    capWidth,
+					color:       errorColor,
+					strokeWidth: errorStrokeWidth,
+				})
+			}
+
 			// Prepare point for mark points
 			points[j] = Point{
 				X: x + (barWidth >> 1), // center of the bar horizontally
@@ -318,7 +346,7 @@ func (b *barChart) renderVerticalBars(result *defaultRenderResult) (Box, error)
 					vertical:  true, // label is vertically oriented
 					index:     index,
 					dataIndex: j,
-					value:     item,
+					value:     labelValueAt(series.labelValues, j, item),
 					fontStyle: fontStyle,
 					x:         x + (barWidth >> 1),
 					y:         labelY,
@@ -440,6 +468,11 @@ func (b *barChart) Render() (Box, error) {
 	if len(valueAxis) == 0 {
 		valueAxis = []ValueAxisOption{{}}
 	}
+	if flagIs(true, opt.StackPercent) {
+		opt.StackSeries = Ptr(true)
+		opt.SeriesList = opt.SeriesList.stackPercent(flagIs(true, opt.StackPercentRawLabels))
+		valueAxis[0] = stackPercentValueAxis(valueAxis[0])
+	}
 	categoryAxis := opt.CategoryAxis
 	normalizeBarAxisPositions(opt.Horizontal, &categoryAxis, valueAxis)
 
@@ -497,8 +530,9 @@ func (b *barChart) renderHorizontalBars(result *defaultRenderResult) (Box, error
 
 	markPointPainter := newMarkPointPainter(seriesPainter)
 	markLinePainter := newMarkLinePainter(seriesPainter)
+	errorBarPainter := newErrorBarPainter(seriesPainter)
 	// render list must start with the markPointPainter, as it can influence label painters (if enabled)
-	rendererList := []renderer{markPointPainter, markLinePainter}
+	rendererList := []renderer{markPointPainter, markLinePainter, errorBarPainter}
 
 	for index, series := range opt.SeriesList {
 		seriesThemeIndex := index
@@ -513,6 +547,14 @@ func (b *barChart) renderHorizontalBars(result *defaultRenderResult) (Box, error
 			rendererList = append(rendererList, labelPainter)
 		}
 
+		var errorColor Color
+		var errorStrokeWidth, stdDevError float64
+		var capWidth int
+		if series.ErrorBar.isSet() {
+			errorColor, errorStrokeWidth, capWidth = errorBarStyle(series.ErrorBar, seriesColor, barHeight/2)
+			stdDevError = series.ErrorBar.stdDevError(series.Values)
+		}
+
 		points := make([]Point, len(series.Values))
 		for j, item := range series.Values {
 			if j >= yRange.divideCount {
@@ -560,6 +602,20 @@ func (b *barChart) renderHorizontalBars(result *defaultRenderResult) (Box, error
 				seriesPainter.FilledRect(left, y, right, y+barHeight, seriesColor, seriesColor, 0.0)
 			}
 
+			if low, high, ok := series.ErrorBar.bounds(j, item, stdDevError); ok {
+				// positioned relative to the bar tip so stacked bars offset the error range
+				valueRange := result.valueAxisRanges[0]
+				errorBarPainter.add(errorBarRenderOption{
+					center:      y + (barHeight >> 1),
+					low:         tipX + dir*(valueRange.getHeight(low)-w),
+					high:        tipX + dir*(valueRange.getHeight(high)-w),
+					horizontal:  true,
+					capWidth:    capWidth,
+					color:       errorColor,
+					strokeWidth: errorStrokeWidth,
+				})
+			}
+
 			// Prepare point for mark points (anchor at the bar's value-end)
 			points[j] = Point{
 				X: tipX,
@@ -593,7 +649,7 @@ func (b *barChart) renderHorizontalBars(result *defaultRenderResult) (Box, error
 					vertical:  false, // horizontal label
 					index:     index,
 					dataIndex: j,
-					value:     item,
+					value:     labelValueAt(series.labelValues, j, item),
 					x:         labelX,
 					y:         labelY,
 					offset:    series.Label.Offset,
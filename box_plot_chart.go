@@ -0,0 +1,315 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/go-analyze/bulk"
+)
+
+const (
+	// BoxPlotWhiskerTukey extends whiskers to the furthest samples within 1.5×IQR of the box, samples beyond are outliers.
+	BoxPlotWhiskerTukey = "tukey"
+	// BoxPlotWhiskerMinMax extends whiskers to the sample minimum and maximum, no outliers are produced.
+	BoxPlotWhiskerMinMax = "minmax"
+)
+
+// BoxPlotChartOption defines the options for rendering a box plot chart. Render the chart using Painter.BoxPlotChart.
+type BoxPlotChartOption struct {
+	// Theme specifies the colors used for the box plot chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// SeriesList provides the data population for the chart, one box per series. Typically constructed using
+	// NewSeriesListBoxPlot or NewSeriesListBoxPlotWithSamples.
+	SeriesList BoxPlotSeriesList
+	// Horizontal when true renders horizontal boxes, swapping the category and value axis.
+	Horizontal bool
+	// CategoryAxis configures the category axis. Labels default to the series names.
+	CategoryAxis CategoryAxisOption
+	// ValueAxis configures the value (numeric) axis.
+	ValueAxis ValueAxisOption
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the data legend.
+	Legend LegendOption
+	// BoxWidth specifies the max width of each box. Accepts a percentage (e.g. "40%") or
+	// pixel value (e.g. "64"). May be reduced to fit.
+	BoxWidth string
+	// ShowMean when *true renders a marker at the series Mean when it is available.
+	ShowMean *bool
+	// StrokeWidth is the stroke width of the box outline and whiskers. Default 1.0.
+	StrokeWidth float64
+	// OutlierRadius is the radius of outlier points. Default 3.0.
+	OutlierRadius float64
+	// ValueFormatter defines how float values are rendered to strings for axis labels.
+	ValueFormatter ValueFormatter
+}
+
+type boxPlotChart struct {
+	p   *Painter
+	opt *BoxPlotChartOption
+}
+
+// newBoxPlotChart returns a box plot chart renderer.
+func newBoxPlotChart(p *Painter, opt BoxPlotChartOption) *boxPlotChart {
+	return &boxPlotChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewBoxPlotChartOptionWithData returns an initialized BoxPlotChartOption from precomputed
+// [Min, Q1, Median, Q3, Max] summaries.
+func NewBoxPlotChartOptionWithData(data [][5]float64) BoxPlotChartOption {
+	return NewBoxPlotChartOptionWithSeries(NewSeriesListBoxPlot(data))
+}
+
+// NewBoxPlotChartOptionWithSeries returns an initialized BoxPlotChartOption with the provided SeriesList.
+func NewBoxPlotChartOptionWithSeries(sl BoxPlotSeriesList) BoxPlotChartOption {
+	return BoxPlotChartOption{
+		SeriesList:     sl,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// NewBoxPlotChartOptionWithSamples builds a box plot chart option by summarizing raw sample values.
+// The optional whisker argument may be BoxPlotWhiskerTukey (default) or BoxPlotWhiskerMinMax.
+func NewBoxPlotChartOptionWithSamples(samples [][]float64, whisker ...string) (BoxPlotChartOption, error) {
+	var mode string
+	for _, w := range whisker {
+		if w == "" {
+			continue
+		} else if w != BoxPlotWhiskerTukey && w != BoxPlotWhiskerMinMax {
+			return BoxPlotChartOption{}, errors.New("invalid whisker mode")
+		} else if mode != "" && mode != w {
+			return BoxPlotChartOption{}, errors.New("conflicting whisker mode")
+		}
+		mode = w
+	}
+
+	sl := NewSeriesListBoxPlotWithSamples(samples, BoxPlotSeriesOption{Whisker: mode})
+	if !slices.ContainsFunc(sl, func(s BoxPlotSeries) bool { return s.isValid() }) {
+		return BoxPlotChartOption{}, errors.New("series with no valid data")
+	}
+	return NewBoxPlotChartOptionWithSeries(sl), nil
+}
+
+// summarizeBoxPlotSamples computes the box summary for the samples, null values are ignored.
+func summarizeBoxPlotSamples(samples []float64, whisker string) BoxPlotSeries {
+	sorted := bulk.SliceFilter(isValidExtent, samples)
+	if len(sorted) == 0 {
+		nullValue := GetNullValue()
+		return BoxPlotSeries{Min: nullValue, Q1: nullValue, Median: nullValue, Q3: nullValue, Max: nullValue}
+	}
+	slices.Sort(sorted)
+	summary := summarizePopulationData(sorted)
+
+	s := BoxPlotSeries{
+		Min:    summary.Min,
+		Q1:     sortedQuantile(sorted, 0.25),
+		Median: summary.Median,
+		Q3:     sortedQuantile(sorted, 0.75),
+		Max:    summary.Max,
+		Mean:   Ptr(summary.Average),
+	}
+	if whisker == BoxPlotWhiskerMinMax {
+		return s
+	}
+
+	iqr := s.Q3 - s.Q1
+	lowerFence, upperFence := s.Q1-1.5*iqr, s.Q3+1.5*iqr
+	s.Min, s.Max = s.Q1, s.Q3
+	for _, v := range sorted {
+		if v < lowerFence || v > upperFence {
+			s.Outliers = append(s.Outliers, v)
+			continue
+		}
+		s.Min = min(s.Min, v)
+		s.Max = max(s.Max, v)
+	}
+	return s
+}
+
+// sortedQuantile returns the linearly interpolated quantile q (0.0–1.0) of the sorted values.
+func sortedQuantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	if lower == upper {
+		return sorted[lower]
+	}
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+// boxPlotConfigureRenderOption sets the category labels to the series names when not provided.
+func boxPlotConfigureRenderOption(sl BoxPlotSeriesList, catAxis CategoryAxisOption) CategoryAxisOption {
+	if len(catAxis.Labels) == 0 {
+		labels := sl.names()
+		for i := range labels {
+			if labels[i] == "" {
+				labels[i] = strconv.Itoa(i + 1)
+			}
+		}
+		catAxis.Labels = labels
+	}
+	return catAxis
+}
+
+func (b *boxPlotChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := b.p
+	opt := b.opt
+	if len(opt.SeriesList) == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	for i := range opt.SeriesList {
+		if opt.SeriesList[i].YAxisIndex != 0 {
+			return BoxZero, errors.New("box plot series specified invalid y-axis index")
+		}
+	}
+	seriesPainter := result.seriesPainter
+
+	categoryRange := result.categoryAxisRange
+	valueRange := result.valueAxisRanges[0]
+	if categoryRange.divideCount == 0 {
+		return BoxZero, errors.New("box plot category axis produced no slots")
+	}
+
+	c0, c1 := categoryRange.getRange(0)
+	slotSize := int(c1 - c0)
+	var parsedBoxWidth int
+	if opt.BoxWidth != "" {
+		if w, err := parseFlexibleValue(opt.BoxWidth, float64(slotSize)); err == nil && w > 0 {
+			parsedBoxWidth = int(w)
+		}
+	}
+	margin, _, boxWidth := calculateGroupMarginsAndSize(1, slotSize, parsedBoxWidth, nil)
+	divideValues := categoryRange.autoDivide()
+
+	strokeWidth := opt.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1.0
+	}
+	outlierRadius := opt.OutlierRadius
+	if outlierRadius <= 0 {
+		outlierRadius = 3.0
+	}
+	showMean := flagIs(true, opt.ShowMean)
+	backgroundColor := opt.Theme.GetBackgroundColor()
+
+	// valuePos maps a value to the pixel offset along the value axis
+	valuePos := func(v float64) int {
+		if opt.Horizontal {
+			return valueRange.valuePosition(v)
+		}
+		return valueRange.getRestHeight(v)
+	}
+	// toPoint converts a category offset and value position into painter coordinates
+	toPoint := func(categoryPos, valuePos int) Point {
+		if opt.Horizontal {
+			return Point{X: valuePos, Y: categoryPos}
+		}
+		return Point{X: categoryPos, Y: valuePos}
+	}
+
+	for index, series := range opt.SeriesList {
+		if index >= categoryRange.divideCount {
+			break
+		} else if !isValidExtent(series.Min) && !isValidExtent(series.Max) {
+			continue // no data for this series
+		} else if !series.isValid() {
+			return BoxZero, fmt.Errorf("invalid box plot summary for series index %d", index)
+		}
+		seriesThemeIndex := index
+		if series.absThemeIndex != nil {
+			seriesThemeIndex = *series.absThemeIndex
+		}
+		seriesColor := opt.Theme.GetSeriesColor(seriesThemeIndex)
+		strokeColor := seriesColor.WithAdjustHSL(0.0, 0.1, -0.2)
+
+		slot := index
+		if opt.Horizontal { // first series at the top, matching horizontal bars
+			slot = categoryRange.divideCount - index - 1
+		}
+		boxStart := divideValues[slot] + margin
+		boxEnd := boxStart + boxWidth
+		center := boxStart + (boxWidth >> 1)
+		capStart := center - (boxWidth >> 2)
+		capEnd := center + (boxWidth >> 2)
+
+		minPos, q1Pos, medianPos := valuePos(series.Min), valuePos(series.Q1), valuePos(series.Median)
+		q3Pos, maxPos := valuePos(series.Q3), valuePos(series.Max)
+
+		// whiskers and caps
+		seriesPainter.LineStroke([]Point{toPoint(center, minPos), toPoint(center, q1Pos)}, strokeColor, strokeWidth)
+		seriesPainter.LineStroke([]Point{toPoint(center, q3Pos), toPoint(center, maxPos)}, strokeColor, strokeWidth)
+		seriesPainter.LineStroke([]Point{toPoint(capStart, minPos), toPoint(capEnd, minPos)}, strokeColor, strokeWidth)
+		seriesPainter.LineStroke([]Point{toPoint(capStart, maxPos), toPoint(capEnd, maxPos)}, strokeColor, strokeWidth)
+
+		// box from Q1 to Q3
+		boxTopLeft, boxBottomRight := toPoint(boxStart, q1Pos), toPoint(boxEnd, q3Pos)
+		seriesPainter.FilledRect(min(boxTopLeft.X, boxBottomRight.X), min(boxTopLeft.Y, boxBottomRight.Y),
+			max(boxTopLeft.X, boxBottomRight.X), max(boxTopLeft.Y, boxBottomRight.Y),
+			seriesColor, strokeColor, strokeWidth)
+
+		// median line across the box
+		seriesPainter.LineStroke([]Point{toPoint(boxStart, medianPos), toPoint(boxEnd, medianPos)},
+			strokeColor, strokeWidth*2)
+
+		if showMean && series.Mean != nil && isValidExtent(*series.Mean) {
+			meanPoint := toPoint(center, valuePos(*series.Mean))
+			markerSize := ceilFloatToInt(outlierRadius * 3)
+			seriesPainter.FilledDiamond(meanPoint.X, meanPoint.Y, markerSize, markerSize,
+				backgroundColor, strokeColor, strokeWidth)
+		}
+
+		for _, outlier := range series.Outliers {
+			if !isValidExtent(outlier) {
+				continue
+			}
+			pt := toPoint(center, valuePos(outlier))
+			seriesPainter.Circle(outlierRadius, pt.X, pt.Y, backgroundColor, seriesColor, strokeWidth)
+		}
+	}
+	return p.box, nil
+}
+
+func (b *boxPlotChart) Render() (Box, error) {
+	p := b.p
+	opt := b.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+
+	categoryAxis := boxPlotConfigureRenderOption(opt.SeriesList, opt.CategoryAxis)
+	valueAxis := []ValueAxisOption{opt.ValueAxis}
+	normalizeBarAxisPositions(opt.Horizontal, &categoryAxis, valueAxis)
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:          opt.Theme,
+		padding:        opt.Padding,
+		seriesList:     opt.SeriesList,
+		categoryAxis:   &categoryAxis,
+		valueAxis:      valueAxis,
+		title:          opt.Title,
+		legend:         &opt.Legend,
+		valueFormatter: opt.ValueFormatter,
+		categoryY:      opt.Horizontal,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return b.renderChart(renderResult)
+}
@@ -0,0 +1,325 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicBoxPlotChartOption() BoxPlotChartOption {
+	seriesList := NewSeriesListBoxPlot([][5]float64{
+		{12, 18, 24, 31, 44},
+		{8, 15, 19, 22, 30},
+		{20, 26, 35, 41, 52},
+	}, BoxPlotSeriesOption{
+		Names: []string{"api", "web", "db"},
+	})
+	return BoxPlotChartOption{
+		Theme:      GetDefaultTheme(),
+		Padding:    NewBoxEqual(10),
+		SeriesList: seriesList,
+		Legend: LegendOption{
+			Show: Ptr(false),
+		},
+	}
+}
+
+func makeSampleBoxPlotChartOption(t *testing.T) BoxPlotChartOption {
+	t.Helper()
+
+	opt, err := NewBoxPlotChartOptionWithSamples([][]float64{
+		{12, 14, 15, 15, 16, 18, 19, 21, 22, 24, 48, 55},
+		{30, 31, 33, 34, 35, 35, 36, 38, 40, 41, 2},
+	})
+	require.NoError(t, err)
+	opt.SeriesList[0].Name = "p50"
+	opt.SeriesList[1].Name = "p99"
+	opt.Padding = NewBoxEqual(10)
+	return opt
+}
+
+func TestNewBoxPlotChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewBoxPlotChartOptionWithData([][5]float64{{1, 2, 3, 4, 5}})
+
+	require.Len(t, opt.SeriesList, 1)
+	assert.Equal(t, ChartTypeBoxPlot, opt.SeriesList[0].getType())
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.InDelta(t, 3.0, opt.SeriesList[0].Median, 0)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.BoxPlotChart(opt))
+}
+
+func TestNewBoxPlotChartOptionWithSamples(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tukey", func(t *testing.T) {
+		opt, err := NewBoxPlotChartOptionWithSamples([][]float64{{1, 2, 3, 4, 5, 6, 7, 8, 100}})
+		require.NoError(t, err)
+		require.Len(t, opt.SeriesList, 1)
+		s := opt.SeriesList[0]
+		assert.InDelta(t, 3.0, s.Q1, 1e-12)
+		assert.InDelta(t, 5.0, s.Median, 1e-12)
+		assert.InDelta(t, 7.0, s.Q3, 1e-12)
+		assert.InDelta(t, 1.0, s.Min, 1e-12)
+		assert.InDelta(t, 8.0, s.Max, 1e-12)
+		assert.Equal(t, []float64{100}, s.Outliers)
+		require.NotNil(t, s.Mean)
+		assert.InDelta(t, 136.0/9, *s.Mean, 1e-12)
+	})
+	t.Run("minmax", func(t *testing.T) {
+		opt, err := NewBoxPlotChartOptionWithSamples([][]float64{{1, 2, 3, 4, 5, 6, 7, 8, 100}},
+			BoxPlotWhiskerMinMax)
+		require.NoError(t, err)
+		s := opt.SeriesList[0]
+		assert.InDelta(t, 1.0, s.Min, 1e-12)
+		assert.InDelta(t, 100.0, s.Max, 1e-12)
+		assert.Empty(t, s.Outliers)
+	})
+	t.Run("null_values", func(t *testing.T) {
+		opt, err := NewBoxPlotChartOptionWithSamples([][]float64{
+			{GetNullValue(), 4, 2, GetNullValue(), 6},
+			{GetNullValue()},
+		})
+		require.NoError(t, err)
+		require.Len(t, opt.SeriesList, 2)
+		assert.InDelta(t, 4.0, opt.SeriesList[0].Median, 1e-12)
+		assert.True(t, opt.SeriesList[0].isValid())
+		assert.False(t, opt.SeriesList[1].isValid())
+	})
+}
+
+func TestNewBoxPlotChartOptionWithSamplesError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		samples          [][]float64
+		whisker          []string
+		errorMsgContains string
+	}{
+		{
+			name:             "no_data",
+			samples:          [][]float64{{}, {GetNullValue()}},
+			errorMsgContains: "no valid data",
+		},
+		{
+			name:             "invalid_whisker",
+			samples:          [][]float64{{1, 2, 3}},
+			whisker:          []string{"range"},
+			errorMsgContains: "invalid whisker mode",
+		},
+		{
+			name:             "conflicting_whisker",
+			samples:          [][]float64{{1, 2, 3}},
+			whisker:          []string{BoxPlotWhiskerTukey, BoxPlotWhiskerMinMax},
+			errorMsgContains: "conflicting whisker mode",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			_, err := NewBoxPlotChartOptionWithSamples(tt.samples, tt.whisker...)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsgContains)
+		})
+	}
+}
+
+func TestSortedQuantile(t *testing.T) {
+	t.Parallel()
+
+	sorted := []float64{1, 2, 3, 4}
+	assert.InDelta(t, 1.0, sortedQuantile(sorted, 0), 1e-12)
+	assert.InDelta(t, 1.75, sortedQuantile(sorted, 0.25), 1e-12)
+	assert.InDelta(t, 2.5, sortedQuantile(sorted, 0.5), 1e-12)
+	assert.InDelta(t, 3.25, sortedQuantile(sorted, 0.75), 1e-12)
+	assert.InDelta(t, 4.0, sortedQuantile(sorted, 1), 1e-12)
+	assert.InDelta(t, 0.0, sortedQuantile(nil, 0.5), 0)
+}
+
+func TestBoxPlotChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func(t *testing.T) BoxPlotChartOption
+	}{
+		{
+			name: "basic_vertical",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				return makeBasicBoxPlotChartOption()
+			},
+		},
+		{
+			name: "basic_horizontal",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				opt := makeBasicBoxPlotChartOption()
+				opt.Horizontal = true
+				return opt
+			},
+		},
+		{
+			name:        "samples_outliers",
+			makeOptions: makeSampleBoxPlotChartOption,
+		},
+		{
+			name: "samples_mean_horizontal",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				opt := makeSampleBoxPlotChartOption(t)
+				opt.Horizontal = true
+				opt.ShowMean = Ptr(true)
+				opt.Legend.Show = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "all_config",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				opt := makeSampleBoxPlotChartOption(t)
+				opt.Title = TitleOption{Text: "Latency"}
+				opt.BoxWidth = "40"
+				opt.StrokeWidth = 2
+				opt.OutlierRadius = 5
+				opt.ShowMean = Ptr(true)
+				opt.CategoryAxis.Labels = []string{"fast", "slow"}
+				opt.ValueAxis = ValueAxisOption{Title: "ms", Min: Ptr(0.0), Max: Ptr(60.0)}
+				return opt
+			},
+		},
+		{
+			name: "dark_theme",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				opt := makeBasicBoxPlotChartOption()
+				opt.Theme = GetTheme(ThemeVividDark)
+				return opt
+			},
+		},
+		{
+			name: "unnamed_series",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				return NewBoxPlotChartOptionWithData([][5]float64{{1, 2, 3, 4, 5}, {2, 3, 4, 5, 6}})
+			},
+		},
+		{
+			name: "null_series",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				opt, err := NewBoxPlotChartOptionWithSamples([][]float64{{1, 2, 3, 4, 5}, {}, {3, 4, 5, 6}})
+				require.NoError(t, err)
+				return opt
+			},
+		},
+		{
+			name: "empty_series",
+			makeOptions: func(t *testing.T) BoxPlotChartOption {
+				return NewBoxPlotChartOptionWithSeries(BoxPlotSeriesList{})
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.BoxPlotChart(tt.makeOptions(t)))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestBoxPlotChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		makeOptions      func() BoxPlotChartOption
+		errorMsgContains string
+	}{
+		{
+			name: "invalid_yaxis_index",
+			makeOptions: func() BoxPlotChartOption {
+				opt := makeBasicBoxPlotChartOption()
+				opt.SeriesList[0].YAxisIndex = 1
+				return opt
+			},
+			errorMsgContains: "invalid y-axis index",
+		},
+		{
+			name: "unordered_summary",
+			makeOptions: func() BoxPlotChartOption {
+				return NewBoxPlotChartOptionWithData([][5]float64{{1, 2, 3, 4, 5}, {5, 4, 3, 2, 1}})
+			},
+			errorMsgContains: "invalid box plot summary for series index 1",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			err := p.BoxPlotChart(tt.makeOptions())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsgContains)
+		})
+	}
+}
+
+func TestBoxPlotRender(t *testing.T) {
+	t.Parallel()
+
+	p, err := BoxPlotRender([][]float64{
+		{12, 14, 15, 15, 16, 18, 19, 21, 22, 24, 48},
+		{30, 31, 33, 34, 35, 35, 36, 38, 40, 41},
+	},
+		SVGOutputOptionFunc(),
+		XAxisLabelsOptionFunc([]string{"A", "B"}),
+	)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+}
+
+func TestRenderBoxPlotCanNotMix(t *testing.T) {
+	t.Parallel()
+
+	boxSeries := NewSeriesListBoxPlot([][5]float64{{1, 2, 3, 4, 5}}).ToGenericSeriesList()
+	lineSeries := NewSeriesListLine([][]float64{{1, 2, 3}}).ToGenericSeriesList()
+	mixed := append(boxSeries, lineSeries...)
+
+	_, err := Render(ChartOption{SeriesList: mixed})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "box plot can not mix other charts")
+}
+
+func TestBoxPlotSeriesListToGenericSeriesList(t *testing.T) {
+	t.Parallel()
+
+	sl := BoxPlotSeriesList{
+		{Min: 1, Q1: 2, Median: 3, Q3: 4, Max: 5, Outliers: []float64{9}, Name: "a"},
+		{Min: 2, Q1: 3, Median: 4, Q3: 5, Max: 6, Name: "b"},
+	}
+	generic := sl.ToGenericSeriesList()
+	require.Len(t, generic, 2)
+	assert.Equal(t, []float64{1, 2, 3, 4, 5, 9}, generic[0].Values)
+	assert.Equal(t, 1, generic.getSeriesLen(0))
+
+	roundTrip := filterSeriesList[BoxPlotSeriesList](generic, ChartTypeBoxPlot)
+	require.Len(t, roundTrip, 2)
+	assert.Equal(t, []float64{9}, roundTrip[0].Outliers)
+	assert.InDelta(t, 6.0, roundTrip[1].Max, 0)
+	require.NotNil(t, roundTrip[1].absThemeIndex)
+	assert.Equal(t, 1, *roundTrip[1].absThemeIndex)
+}
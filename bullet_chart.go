@@ -0,0 +1,258 @@
+package charts
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+)
+
+// BulletSeries is a single measure rendered as a bullet, with a bar for the value, a tick for the target, and
+// qualitative range bands behind them.
+type BulletSeries struct {
+	// Name specifies the category label for the bullet.
+	Name string
+	// Value is the primary measure, rendered as a bar from the start of the value axis. Null values are not rendered.
+	Value float64
+	// Target is the comparative measure, rendered as a tick across the bar. Null values are not rendered.
+	Target float64
+	// Ranges provides the upper bounds of the qualitative bands (for example poor, ok, good), each band starting at
+	// the end of the prior one.
+	Ranges []float64
+}
+
+// BulletChartOption defines the options for rendering a bullet chart. Render the chart using Painter.BulletChart.
+type BulletChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// SeriesList provides the bullets for the chart, one per category.
+	SeriesList []BulletSeries
+	// Horizontal when true renders horizontal bullets, swapping the category and value axis.
+	Horizontal bool
+	// CategoryAxis configures the category axis. Labels default to the series names.
+	CategoryAxis CategoryAxisOption
+	// ValueAxis configures the value (numeric) axis. The minimum defaults to zero.
+	ValueAxis ValueAxisOption
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// RangeWidth specifies the max width of the range bands. Accepts a percentage (e.g. "60%") or
+	// pixel value (e.g. "40"). May be reduced to fit.
+	RangeWidth string
+	// BarWidth specifies the width of the measure bar relative to the range bands. Accepts a percentage or pixel
+	// value. Default is "35%".
+	BarWidth string
+	// BarColor sets the color of the measure bar. Default is the first theme series color.
+	BarColor Color
+	// TargetColor sets the color of the target tick. Default is the theme label text color.
+	TargetColor Color
+	// TargetStrokeWidth is the stroke width of the target tick. Default is 3.
+	TargetStrokeWidth float64
+	// RangeColors sets the color of each range band, from the lowest band. Defaults to shades of the theme label
+	// text color, darkest for the lowest band.
+	RangeColors []Color
+	// ValueFormatter defines how float values are rendered to strings for axis labels.
+	ValueFormatter ValueFormatter
+}
+
+type bulletChart struct {
+	p   *Painter
+	opt *BulletChartOption
+}
+
+// newBulletChart returns a bullet chart renderer.
+func newBulletChart(p *Painter, opt BulletChartOption) *bulletChart {
+	return &bulletChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewBulletChartOptionWithData returns an initialized BulletChartOption with a bullet for each value. The targets
+// and ranges are matched to the values by index.
+func NewBulletChartOptionWithData(values, targets []float64, ranges [][]float64) BulletChartOption {
+	seriesList := make([]BulletSeries, len(values))
+	for i, v := range values {
+		seriesList[i] = BulletSeries{Value: v, Target: GetNullValue()}
+		if i < len(targets) {
+			seriesList[i].Target = targets[i]
+		}
+		if i < len(ranges) {
+			seriesList[i].Ranges = ranges[i]
+		}
+	}
+	return BulletChartOption{
+		SeriesList:     seriesList,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// bulletRangeColor returns the color for the range band at the index.
+func (b *bulletChart) bulletRangeColor(index, count int) Color {
+	if index < len(b.opt.RangeColors) {
+		return b.opt.RangeColors[index]
+	}
+	const maxAlpha, minAlpha = 112, 32
+	alpha := maxAlpha
+	if count > 1 {
+		alpha -= (maxAlpha - minAlpha) * index / (count - 1)
+	}
+	return b.opt.Theme.GetLabelTextColor().WithAlpha(uint8(alpha))
+}
+
+func (b *bulletChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := b.p
+	opt := b.opt
+	if len(opt.SeriesList) == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	seriesPainter := result.seriesPainter
+
+	categoryRange := result.categoryAxisRange
+	valueRange := result.valueAxisRanges[0]
+	if categoryRange.divideCount == 0 {
+		return BoxZero, errors.New("bullet chart category axis produced no slots")
+	}
+
+	c0, c1 := categoryRange.getRange(0)
+	slotSize := int(c1 - c0)
+	var parsedRangeWidth int
+	if opt.RangeWidth != "" {
+		if w, err := parseFlexibleValue(opt.RangeWidth, float64(slotSize)); err == nil && w > 0 {
+			parsedRangeWidth = int(w)
+		}
+	}
+	margin, _, rangeWidth := calculateGroupMarginsAndSize(1, slotSize, parsedRangeWidth, nil)
+	barWidth := rangeWidth * 35 / 100
+	if opt.BarWidth != "" {
+		if w, err := parseFlexibleValue(opt.BarWidth, float64(rangeWidth)); err == nil && w > 0 {
+			barWidth = min(int(w), rangeWidth)
+		}
+	}
+	barWidth = max(barWidth, 1)
+	targetLength := rangeWidth * 7 / 10
+	divideValues := categoryRange.autoDivide()
+
+	barColor := opt.BarColor
+	if barColor.IsZero() {
+		barColor = opt.Theme.GetSeriesColor(0)
+	}
+	targetColor := opt.TargetColor
+	if targetColor.IsZero() {
+		targetColor = opt.Theme.GetLabelTextColor()
+	}
+	targetStrokeWidth := opt.TargetStrokeWidth
+	if targetStrokeWidth <= 0 {
+		targetStrokeWidth = 3
+	}
+
+	clampValue := func(v float64) float64 {
+		return min(max(v, valueRange.min), valueRange.max)
+	}
+	// valuePos maps a value to the pixel offset along the value axis
+	valuePos := func(v float64) int {
+		if opt.Horizontal {
+			return valueRange.valuePosition(clampValue(v))
+		}
+		return valueRange.getRestHeight(clampValue(v))
+	}
+	// fillSpan fills the rectangle between the category offsets and value positions
+	fillSpan := func(catStart, catEnd, valueStart, valueEnd int, color Color) {
+		if opt.Horizontal {
+			seriesPainter.FilledRect(min(valueStart, valueEnd), catStart, max(valueStart, valueEnd), catEnd,
+				color, color, 0)
+		} else {
+			seriesPainter.FilledRect(catStart, min(valueStart, valueEnd), catEnd, max(valueStart, valueEnd),
+				color, color, 0)
+		}
+	}
+
+	for index, series := range opt.SeriesList {
+		if index >= categoryRange.divideCount {
+			break
+		}
+		slot := index
+		if opt.Horizontal { // reversed to align with the vertical category axis labels
+			slot = categoryRange.divideCount - index - 1
+		}
+		rangeStart := divideValues[slot] + margin
+		rangeEnd := rangeStart + rangeWidth
+		center := rangeStart + (rangeWidth >> 1)
+
+		ranges := slices.Clone(series.Ranges)
+		ranges = slices.DeleteFunc(ranges, func(v float64) bool { return !isValidExtent(v) })
+		slices.Sort(ranges)
+		bandStart := valueRange.min
+		for i, bandEnd := range ranges {
+			if bandEnd > bandStart {
+				fillSpan(rangeStart, rangeEnd, valuePos(bandStart), valuePos(bandEnd), b.bulletRangeColor(i, len(ranges)))
+				bandStart = bandEnd
+			}
+		}
+
+		if isValidExtent(series.Value) {
+			barStart := center - (barWidth >> 1)
+			fillSpan(barStart, barStart+barWidth, valuePos(max(valueRange.min, 0)), valuePos(series.Value), barColor)
+		}
+		if isValidExtent(series.Target) {
+			pos := valuePos(series.Target)
+			targetStart, targetEnd := center-(targetLength>>1), center+(targetLength>>1)
+			if opt.Horizontal {
+				seriesPainter.LineStroke([]Point{{X: pos, Y: targetStart}, {X: pos, Y: targetEnd}},
+					targetColor, targetStrokeWidth)
+			} else {
+				seriesPainter.LineStroke([]Point{{X: targetStart, Y: pos}, {X: targetEnd, Y: pos}},
+					targetColor, targetStrokeWidth)
+			}
+		}
+	}
+	return p.box, nil
+}
+
+func (b *bulletChart) Render() (Box, error) {
+	p := b.p
+	opt := b.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+
+	categoryAxis := opt.CategoryAxis
+	if len(categoryAxis.Labels) == 0 {
+		categoryAxis.Labels = make([]string, len(opt.SeriesList))
+		for i, series := range opt.SeriesList {
+			categoryAxis.Labels[i] = series.Name
+			if series.Name == "" {
+				categoryAxis.Labels[i] = strconv.Itoa(i + 1)
+			}
+		}
+	}
+	valueAxis := []ValueAxisOption{opt.ValueAxis}
+	if valueAxis[0].Min == nil {
+		valueAxis[0].Min = Ptr(0.0) // bars grow from zero
+	}
+	normalizeBarAxisPositions(opt.Horizontal, &categoryAxis, valueAxis)
+
+	var values []float64
+	for _, series := range opt.SeriesList {
+		values = append(values, series.Value, series.Target)
+		values = append(values, series.Ranges...)
+	}
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:          opt.Theme,
+		padding:        opt.Padding,
+		seriesList:     valuesFakeSeries{values: values},
+		categoryAxis:   &categoryAxis,
+		valueAxis:      valueAxis,
+		title:          opt.Title,
+		legend:         &LegendOption{Show: Ptr(false)},
+		valueFormatter: opt.ValueFormatter,
+		categoryY:      opt.Horizontal,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return b.renderChart(renderResult)
+}
@@ -0,0 +1,162 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBulletKPIOption() BulletChartOption {
+	opt := NewBulletChartOptionWithData([]float64{270, 210, 82}, []float64{250, 260, 90},
+		[][]float64{{150, 225, 300}, {180, 240, 300}, {60, 80, 100}})
+	opt.SeriesList[0].Name = "Revenue"
+	opt.SeriesList[1].Name = "Profit"
+	opt.SeriesList[2].Name = "Satisfaction"
+	return opt
+}
+
+func TestNewBulletChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewBulletChartOptionWithData([]float64{1, 2}, []float64{3}, [][]float64{{4, 5}})
+
+	require.Len(t, opt.SeriesList, 2)
+	assert.InDelta(t, 3.0, opt.SeriesList[0].Target, 0)
+	assert.Equal(t, []float64{4, 5}, opt.SeriesList[0].Ranges)
+	assert.InDelta(t, GetNullValue(), opt.SeriesList[1].Target, 0)
+	assert.Empty(t, opt.SeriesList[1].Ranges)
+	assert.Equal(t, defaultPadding, opt.Padding)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.BulletChart(opt))
+}
+
+func TestBulletChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		width       int
+		height      int
+		makeOptions func() BulletChartOption
+	}{
+		{
+			name: "vertical",
+			makeOptions: func() BulletChartOption {
+				opt := makeBulletKPIOption()
+				opt.Title.Text = "Q3 KPIs"
+				return opt
+			},
+		},
+		{
+			name: "horizontal",
+			makeOptions: func() BulletChartOption {
+				opt := makeBulletKPIOption()
+				opt.Horizontal = true
+				return opt
+			},
+		},
+		{
+			name:   "compact_single",
+			width:  400,
+			height: 80,
+			makeOptions: func() BulletChartOption {
+				opt := NewBulletChartOptionWithData([]float64{0.72}, []float64{0.8}, [][]float64{{0.5, 0.75, 1}})
+				opt.Horizontal = true
+				opt.Padding = NewBoxEqual(8)
+				opt.SeriesList[0].Name = "Uptime"
+				opt.ValueAxis.Max = Ptr(1.0)
+				opt.ValueFormatter = func(f float64) string {
+					return strconv.Itoa(int(f*100)) + "%"
+				}
+				return opt
+			},
+		},
+		{
+			name: "custom_style",
+			makeOptions: func() BulletChartOption {
+				opt := makeBulletKPIOption()
+				opt.Horizontal = true
+				opt.Theme = GetTheme(ThemeDark)
+				opt.RangeWidth = "50%"
+				opt.BarWidth = "60%"
+				opt.BarColor = ColorGreen
+				opt.TargetColor = ColorRed
+				opt.TargetStrokeWidth = 2
+				opt.RangeColors = []Color{ColorRed.WithAlpha(80), ColorYellow.WithAlpha(80)}
+				return opt
+			},
+		},
+		{
+			name: "null_values",
+			makeOptions: func() BulletChartOption {
+				opt := makeBulletKPIOption()
+				opt.SeriesList[0].Value = GetNullValue()
+				opt.SeriesList[1].Target = GetNullValue()
+				opt.SeriesList[2].Ranges = nil
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() BulletChartOption {
+				return NewBulletChartOptionWithData(nil, nil, nil)
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			width, height := tt.width, tt.height
+			if width == 0 {
+				width, height = 600, 400
+			}
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        width,
+				Height:       height,
+			})
+			require.NoError(t, p.BulletChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestBulletChartLayoutByRows(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        400,
+		Height:       200,
+	})
+	painters, err := p.LayoutByRows().
+		Height("50").Columns("revenue").
+		Row().Height("50").Columns("profit").
+		Row().Height("50").Columns("satisfaction").
+		Row().Columns("uptime").
+		Build()
+	require.NoError(t, err)
+
+	values := []float64{270, 210, 82, 0.72}
+	targets := []float64{250, 260, 90, 0.8}
+	ranges := [][]float64{{150, 225, 300}, {180, 240, 300}, {60, 80, 100}, {0.5, 0.75, 1}}
+	for i, key := range []string{"revenue", "profit", "satisfaction", "uptime"} {
+		opt := NewBulletChartOptionWithData(values[i:i+1], targets[i:i+1], ranges[i:i+1])
+		opt.Horizontal = true
+		opt.Padding = NewBoxEqual(4)
+		opt.SeriesList[0].Name = key
+		opt.CategoryAxis.Show = Ptr(false)
+		opt.ValueAxis.Show = Ptr(false)
+		require.NoError(t, painters[key].BulletChart(opt))
+	}
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	// the legend and both axes are hidden, so no text is rendered
+	assert.NotContains(t, string(data), "<text")
+	assertTestdataSVG(t, data)
+}
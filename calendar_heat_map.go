@@ -0,0 +1,375 @@
+package charts
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/go-analyze/charts/chartdraw/matrix"
+)
+
+// CalendarHeatMapValue is the value for a single day on a calendar heat map.
+type CalendarHeatMapValue struct {
+	// Date specifies the day of the value, the time of day is ignored.
+	Date time.Time
+	// Value provides the data value for the day. Values on the same day are summed.
+	Value float64
+}
+
+// CalendarHeatMapOption contains configuration options for a calendar heat map, which lays out daily values as
+// week columns and weekday rows. Render the chart using Painter.CalendarHeatMapChart.
+type CalendarHeatMapOption struct {
+	// Theme specifies the color palette used for rendering the heat map.
+	Theme ColorPalette
+	// BaseColorIndex specifies which color from the theme palette to use as the base for gradients.
+	BaseColorIndex int
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Values provides the daily data values, in any order.
+	Values []CalendarHeatMapValue
+	// Start sets the first day of the calendar. If zero, the earliest value date is used.
+	Start time.Time
+	// End sets the last day of the calendar. If zero, the latest value date is used.
+	End time.Time
+	// WeekStart specifies the weekday of the first row. Default is time.Sunday.
+	WeekStart time.Weekday
+	// CellGap specifies the spacing between day cells. Default is 2.
+	CellGap *float64
+	// EmptyColor sets the color for days without a value. Default is the theme split line color.
+	EmptyColor Color
+	// ScaleMinValue overrides the minimum value for color gradient calculation. If nil, calculated from the data.
+	ScaleMinValue *float64
+	// ScaleMaxValue overrides the maximum value for color gradient calculation. If nil, calculated from the data.
+	ScaleMaxValue *float64
+	// ShowMonthSeparator specifies if a line should be drawn between the days of each month. Default is true.
+	ShowMonthSeparator *bool
+	// MonthFormat specifies the time layout for the month labels. Default is "Jan".
+	MonthFormat string
+	// MonthLabelFontStyle specifies the font style for the month labels.
+	MonthLabelFontStyle FontStyle
+	// WeekdayLabelFontStyle specifies the font style for the weekday labels, rendered on alternating rows.
+	WeekdayLabelFontStyle FontStyle
+	// ValuesLabel contains configuration for displaying the day values on the cells.
+	ValuesLabel SeriesLabel
+	// ValueFormatter defines how the day values are rendered to strings, when not set on the ValuesLabel.
+	ValueFormatter ValueFormatter
+}
+
+type calendarHeatMap struct {
+	p   *Painter
+	opt *CalendarHeatMapOption
+}
+
+// newCalendarHeatMapChart returns a calendar heat map renderer.
+func newCalendarHeatMapChart(p *Painter, opt CalendarHeatMapOption) *calendarHeatMap {
+	return &calendarHeatMap{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewCalendarHeatMapOptionWithData returns an initialized CalendarHeatMapOption with the provided daily values.
+func NewCalendarHeatMapOptionWithData(values []CalendarHeatMapValue) CalendarHeatMapOption {
+	return CalendarHeatMapOption{
+		Theme:          GetDefaultTheme(),
+		Padding:        defaultPadding,
+		Values:         values,
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// calendarDay truncates the time to the day, keeping the location so dates are not shifted across days.
+func calendarDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// calendarDaysBetween returns the number of days from start to end, ignoring daylight saving shifts.
+func calendarDaysBetween(start, end time.Time) int {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	startUTC := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+	endUTC := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+	return int(endUTC.Sub(startUTC).Hours() / 24)
+}
+
+// calendarGrid is the layout of the calendar days into week columns.
+type calendarGrid struct {
+	start time.Time
+	// offset is the row of the start day within the first week column.
+	offset int
+	// values holds the summed value for each day from the start, with a bool slice indicating if a value was set.
+	values  []float64
+	present []bool
+}
+
+// newCalendarGrid sums the values for each day within the start and end range.
+func newCalendarGrid(values []CalendarHeatMapValue, start, end time.Time, weekStart time.Weekday) (calendarGrid, error) {
+	autoStart, autoEnd := start.IsZero(), end.IsZero()
+	var first, last time.Time
+	for _, v := range values {
+		day := calendarDay(v.Date)
+		if first.IsZero() || day.Before(first) {
+			first = day
+		}
+		if last.IsZero() || day.After(last) {
+			last = day
+		}
+	}
+	if autoStart {
+		start = first
+	}
+	if autoEnd {
+		end = last
+	}
+	start, end = calendarDay(start), calendarDay(end)
+	if autoStart && start.After(end) {
+		start = end // explicit End before all data
+	} else if autoEnd && end.Before(start) {
+		end = start // explicit Start after all data
+	}
+	if end.Before(start) {
+		return calendarGrid{}, errors.New("calendar heat map End must not be before Start")
+	}
+	days := calendarDaysBetween(start, end) + 1
+	grid := calendarGrid{
+		start:   start,
+		offset:  (int(start.Weekday()) - int(weekStart) + 7) % 7,
+		values:  make([]float64, days),
+		present: make([]bool, days),
+	}
+	for _, v := range values {
+		if !isValidExtent(v.Value) {
+			continue
+		}
+		index := calendarDaysBetween(start, v.Date)
+		if index < 0 || index >= days {
+			continue
+		}
+		grid.values[index] += v.Value
+		grid.present[index] = true
+	}
+	return grid, nil
+}
+
+// position returns the week column and weekday row for the day index.
+func (g calendarGrid) position(index int) (int, int) {
+	slot := index + g.offset
+	return slot / 7, slot % 7
+}
+
+// weekCount returns the number of week columns.
+func (g calendarGrid) weekCount() int {
+	col, _ := g.position(len(g.values) - 1)
+	return col + 1
+}
+
+// valueRange returns the minimum and maximum of the provided day values.
+func (g calendarGrid) valueRange() (float64, float64) {
+	var minVal, maxVal float64
+	var found bool
+	for i, v := range g.values {
+		if !g.present[i] {
+			continue
+		} else if !found {
+			minVal, maxVal, found = v, v, true
+		} else {
+			minVal, maxVal = min(minVal, v), max(maxVal, v)
+		}
+	}
+	return minVal, maxVal
+}
+
+func (c *calendarHeatMap) renderChart(result *defaultRenderResult) (Box, error) {
+	opt := c.opt
+	p := result.seriesPainter
+	if len(opt.Values) == 0 && (opt.Start.IsZero() || opt.End.IsZero()) {
+		result.renderNoData(opt.Theme)
+		return c.p.box, nil
+	}
+	grid, err := newCalendarGrid(opt.Values, opt.Start, opt.End, opt.WeekStart)
+	if err != nil {
+		return BoxZero, err
+	}
+
+	monthFormat := opt.MonthFormat
+	if monthFormat == "" {
+		monthFormat = "Jan"
+	}
+	monthFontStyle := fillFontStyleDefaults(opt.MonthLabelFontStyle, defaultFontSize,
+		opt.Theme.GetXAxisTextColor(), p.font)
+	weekdayFontStyle := fillFontStyleDefaults(opt.WeekdayLabelFontStyle, defaultFontSize,
+		opt.Theme.GetYAxisTextColor(), p.font)
+	weekdayLabels := make([]string, 7)
+	for row := range weekdayLabels {
+		if row%2 == 1 {
+			weekdayLabels[row] = time.Weekday((int(opt.WeekStart) + row) % 7).String()[:3]
+		}
+	}
+	weekdayWidth, _ := p.measureTextMaxWidthHeight(weekdayLabels, 0, weekdayFontStyle)
+	monthHeight := p.MeasureText(grid.start.Format(monthFormat), 0, monthFontStyle).Height()
+
+	// cells are square, sized to fit the available width and height
+	const labelMargin = 6
+	weekCount := grid.weekCount()
+	gridLeft := weekdayWidth + labelMargin
+	gridTop := monthHeight + labelMargin
+	cellSize := min(float64(p.Width()-gridLeft)/float64(weekCount), float64(p.Height()-gridTop)/7)
+	if cellSize < 2 {
+		return BoxZero, errors.New("insufficient space for calendar heat map cells")
+	}
+	gap := 2.0
+	if opt.CellGap != nil {
+		gap = max(*opt.CellGap, 0)
+	}
+	gap = min(gap, cellSize/2)
+	cellX := func(col int) int {
+		return gridLeft + int(math.Round(float64(col)*cellSize))
+	}
+	cellY := func(row int) int {
+		return gridTop + int(math.Round(float64(row)*cellSize))
+	}
+
+	minVal, maxVal := grid.valueRange()
+	if opt.ScaleMinValue != nil {
+		minVal = *opt.ScaleMinValue
+	}
+	if opt.ScaleMaxValue != nil {
+		maxVal = *opt.ScaleMaxValue
+	}
+	valueRange := maxVal - minVal
+	if math.Abs(valueRange) <= matrix.DefaultEpsilon {
+		minVal = 0
+		maxVal = 1
+		valueRange = maxVal - minVal
+	}
+	baseColor := opt.Theme.GetSeriesColor(opt.BaseColorIndex)
+	emptyColor := opt.EmptyColor
+	if emptyColor.IsZero() {
+		emptyColor = opt.Theme.GetAxisSplitLineColor()
+	}
+
+	halfGap := int(math.Round(gap / 2))
+	for i, value := range grid.values {
+		col, row := grid.position(i)
+		cellColor := emptyColor
+		if grid.present[i] {
+			ratio := min(max((value-minVal)/valueRange, 0), 1)
+			cellColor = heatMapCellColor(baseColor, ratio, opt.Theme.IsDark())
+		}
+		p.FilledRect(cellX(col)+halfGap, cellY(row)+halfGap,
+			cellX(col+1)-(int(gap)-halfGap), cellY(row+1)-(int(gap)-halfGap), cellColor, cellColor, 0)
+	}
+
+	if flagIs(true, opt.ValuesLabel.Show) {
+		valuesLabel := opt.ValuesLabel
+		valuesLabel.ValueFormatter = getPreferredValueFormatter(valuesLabel.ValueFormatter, opt.ValueFormatter)
+		valuesLabel.FontStyle = fillFontStyleDefaults(valuesLabel.FontStyle, defaultLabelFontSize,
+			opt.Theme.GetLabelTextColor(), p.font)
+		labelPainter := newSeriesLabelPainter(p, []string{""}, valuesLabel, opt.Theme, opt.Padding.Right)
+		// labels are positioned above the point, offset down so they are centered within the cell
+		offsetTop := p.MeasureText("0", 0, valuesLabel.FontStyle).Height() >> 1
+		if valuesLabel.Distance == 0 {
+			offsetTop += 5
+		} else {
+			offsetTop += valuesLabel.Distance
+		}
+		for i, value := range grid.values {
+			if !grid.present[i] {
+				continue
+			}
+			col, row := grid.position(i)
+			labelPainter.Add(labelValue{
+				index:     0,
+				value:     value,
+				x:         (cellX(col) + cellX(col+1)) >> 1,
+				y:         (cellY(row) + cellY(row+1)) >> 1,
+				fontStyle: valuesLabel.FontStyle,
+				vertical:  true,
+				offset:    OffsetInt{Top: offsetTop},
+			})
+		}
+		if _, err := labelPainter.Render(); err != nil {
+			return BoxZero, err
+		}
+	}
+
+	// weekday labels, vertically centered on their row
+	for row, label := range weekdayLabels {
+		if label == "" {
+			continue
+		}
+		textBox := p.MeasureText(label, 0, weekdayFontStyle)
+		p.Text(label, weekdayWidth-textBox.Width(), (cellY(row)+cellY(row+1)+textBox.Height())/2, 0, weekdayFontStyle)
+	}
+
+	// month labels start at the first column which begins within the month, separators follow the month boundary
+	showSeparator := !flagIs(false, opt.ShowMonthSeparator)
+	separatorColor := opt.Theme.GetXAxisStrokeColor()
+	lastLabelEnd := math.MinInt
+	for i := range grid.values {
+		day := grid.start.AddDate(0, 0, i)
+		if i != 0 && day.Day() != 1 {
+			continue
+		}
+		col, row := grid.position(i)
+		if showSeparator && i != 0 {
+			left, right := cellX(col), cellX(col+1)
+			top, bottom := cellY(0), cellY(7)
+			if row == 0 {
+				p.LineStroke([]Point{{X: left, Y: top}, {X: left, Y: bottom}}, separatorColor, 1)
+			} else {
+				split := cellY(row)
+				p.LineStroke([]Point{
+					{X: left, Y: bottom}, {X: left, Y: split}, {X: right, Y: split}, {X: right, Y: top},
+				}, separatorColor, 1)
+			}
+		}
+		labelCol := col
+		if row != 0 && i != 0 {
+			labelCol++ // the first column is shared with the prior month
+		}
+		if labelCol >= weekCount {
+			continue
+		}
+		label := day.Format(monthFormat)
+		x := cellX(labelCol)
+		if x <= lastLabelEnd {
+			continue // skip labels which would collide
+		}
+		textBox := p.MeasureText(label, 0, monthFontStyle)
+		p.Text(label, x, monthHeight, 0, monthFontStyle)
+		lastLabelEnd = x + textBox.Width() + labelMargin
+	}
+	return c.p.box, nil
+}
+
+func (c *calendarHeatMap) Render() (Box, error) {
+	p := c.p
+	opt := c.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeCalendarHeatMap},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title:  opt.Title,
+		legend: &LegendOption{Show: Ptr(false)},
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return c.renderChart(renderResult)
+}
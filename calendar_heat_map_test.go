@@ -0,0 +1,248 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeCalendarHeatMapValues(start time.Time, days int) []CalendarHeatMapValue {
+	values := make([]CalendarHeatMapValue, 0, days)
+	for d := 0; d < days; d++ {
+		if d%5 == 3 {
+			continue // leave some days empty
+		}
+		values = append(values, CalendarHeatMapValue{
+			Date:  start.AddDate(0, 0, d).Add(time.Duration(d%24) * time.Hour),
+			Value: float64((d * 7) % 11),
+		})
+	}
+	return values
+}
+
+func TestNewCalendarHeatMapOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	values := makeCalendarHeatMapValues(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 10)
+	opt := NewCalendarHeatMapOptionWithData(values)
+
+	assert.Len(t, opt.Values, len(values))
+	assert.Equal(t, GetDefaultTheme(), opt.Theme)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.ValueFormatter)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.CalendarHeatMapChart(opt))
+}
+
+func TestNewCalendarGrid(t *testing.T) {
+	t.Parallel()
+
+	wednesday := time.Date(2024, 1, 3, 15, 0, 0, 0, time.UTC)
+	values := []CalendarHeatMapValue{
+		{Date: wednesday, Value: 2},
+		{Date: wednesday.Add(2 * time.Hour), Value: 3}, // same day, summed
+		{Date: wednesday.AddDate(0, 0, 5), Value: 1},
+		{Date: wednesday.AddDate(0, 0, 6), Value: GetNullValue()},
+	}
+
+	t.Run("data_range", func(t *testing.T) {
+		grid, err := newCalendarGrid(values, time.Time{}, time.Time{}, time.Sunday)
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), grid.start)
+		assert.Equal(t, 3, grid.offset)
+		assert.Equal(t, []float64{5, 0, 0, 0, 0, 1, 0}, grid.values)
+		assert.Equal(t, []bool{true, false, false, false, false, true, false}, grid.present)
+		assert.Equal(t, 2, grid.weekCount())
+		col, row := grid.position(5) // monday
+		assert.Equal(t, 1, col)
+		assert.Equal(t, 1, row)
+		minVal, maxVal := grid.valueRange()
+		assert.InDelta(t, 1.0, minVal, 0)
+		assert.InDelta(t, 5.0, maxVal, 0)
+	})
+	t.Run("configured_range", func(t *testing.T) {
+		grid, err := newCalendarGrid(values, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), time.Monday)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, grid.offset)
+		assert.Equal(t, []float64{0, 0, 5, 0}, grid.values)
+		assert.Equal(t, 1, grid.weekCount())
+	})
+	t.Run("configured_start", func(t *testing.T) {
+		grid, err := newCalendarGrid(values, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), time.Time{}, time.Sunday)
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), grid.start)
+		assert.Equal(t, []float64{0, 0, 0, 1, 0}, grid.values)
+	})
+	t.Run("configured_end", func(t *testing.T) {
+		grid, err := newCalendarGrid(values, time.Time{}, time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), time.Sunday)
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), grid.start)
+		assert.Equal(t, []float64{5, 0}, grid.values)
+	})
+	t.Run("configured_start_after_data", func(t *testing.T) {
+		grid, err := newCalendarGrid(values, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Time{}, time.Sunday)
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), grid.start)
+		assert.Equal(t, []float64{0}, grid.values)
+	})
+	t.Run("invalid_range", func(t *testing.T) {
+		_, err := newCalendarGrid(values, wednesday, wednesday.AddDate(0, 0, -1), time.Sunday)
+		assert.Error(t, err)
+	})
+}
+
+func TestCalendarDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data unavailable")
+	}
+	// spans the daylight saving change, which has a 23-hour day
+	start := time.Date(2024, 3, 9, 0, 0, 0, 0, loc)
+	assert.Equal(t, 2, calendarDaysBetween(start, time.Date(2024, 3, 11, 0, 0, 0, 0, loc)))
+	assert.Equal(t, 0, calendarDaysBetween(start, start.Add(23*time.Hour)))
+}
+
+func TestCalendarHeatMapChart(t *testing.T) {
+	t.Parallel()
+
+	yearStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		width       int
+		height      int
+		makeOptions func() CalendarHeatMapOption
+	}{
+		{
+			name:   "year",
+			width:  900,
+			height: 240,
+			makeOptions: func() CalendarHeatMapOption {
+				opt := NewCalendarHeatMapOptionWithData(makeCalendarHeatMapValues(yearStart, 366))
+				opt.Title.Text = "Deploys"
+				return opt
+			},
+		},
+		{
+			name:   "quarter_monday_start",
+			width:  600,
+			height: 300,
+			makeOptions: func() CalendarHeatMapOption {
+				opt := NewCalendarHeatMapOptionWithData(makeCalendarHeatMapValues(yearStart.AddDate(0, 0, 15), 80))
+				opt.WeekStart = time.Monday
+				opt.Start = yearStart
+				opt.End = time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+				opt.CellGap = Ptr(4.0)
+				opt.BaseColorIndex = 2
+				opt.MonthFormat = "January"
+				return opt
+			},
+		},
+		{
+			name:   "dark_theme_custom",
+			width:  600,
+			height: 300,
+			makeOptions: func() CalendarHeatMapOption {
+				opt := NewCalendarHeatMapOptionWithData(makeCalendarHeatMapValues(yearStart.AddDate(0, 1, 10), 45))
+				opt.Theme = GetTheme(ThemeDark)
+				opt.ShowMonthSeparator = Ptr(false)
+				opt.EmptyColor = ColorBlack
+				opt.ScaleMinValue = Ptr(0.0)
+				opt.ScaleMaxValue = Ptr(20.0)
+				opt.MonthLabelFontStyle = FontStyle{FontSize: 14, FontColor: ColorRed}
+				opt.WeekdayLabelFontStyle = FontStyle{FontSize: 8}
+				return opt
+			},
+		},
+		{
+			name:   "no_data",
+			width:  600,
+			height: 300,
+			makeOptions: func() CalendarHeatMapOption {
+				return NewCalendarHeatMapOptionWithData(nil)
+			},
+		},
+		{
+			name:   "values_label",
+			width:  600,
+			height: 300,
+			makeOptions: func() CalendarHeatMapOption {
+				opt := NewCalendarHeatMapOptionWithData(makeCalendarHeatMapValues(yearStart, 28))
+				opt.ValuesLabel = SeriesLabel{Show: Ptr(true), FontStyle: FontStyle{FontSize: 8}}
+				opt.ValueFormatter = func(f float64) string {
+					return strconv.Itoa(int(f))
+				}
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        tt.width,
+				Height:       tt.height,
+			})
+			require.NoError(t, p.CalendarHeatMapChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestCalendarHeatMapChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		makeOption func() CalendarHeatMapOption
+		errorMsg   string
+	}{
+		{
+			name: "end_before_start",
+			makeOption: func() CalendarHeatMapOption {
+				opt := NewCalendarHeatMapOptionWithData(nil)
+				opt.Start = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+				opt.End = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				return opt
+			},
+			errorMsg: "End must not be before Start",
+		},
+		{
+			name: "insufficient_space",
+			makeOption: func() CalendarHeatMapOption {
+				opt := NewCalendarHeatMapOptionWithData(nil)
+				opt.Start = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+				opt.End = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+				return opt
+			},
+			errorMsg: "insufficient space",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			err := p.CalendarHeatMapChart(tt.makeOption())
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
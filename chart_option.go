@@ -33,6 +33,13 @@ type ChartOption struct {
 	// StackSeries when set to *true causes series to be layered or stacked.
 	// This significantly changes chart visualization; see specific chart godocs for details.
 	StackSeries *bool
+	// StackPercent when set to *true stacks the bar, horizontal bar, and line series with the values at each index
+	// scaled to their percentage share, so each stack sums to 100%. Each chart type is stacked separately. The value
+	// axis is fixed to 0-100% and labels default to the share. Error bars are scaled with the values.
+	StackPercent *bool
+	// StackPercentRawLabels when set to *true with StackPercent renders the original series values in the labels,
+	// rather than the percentage share.
+	StackPercentRawLabels *bool
 	// RadarIndicators is the list of radar indicators for radar charts.
 	RadarIndicators []RadarIndicator
 	// Symbol specifies the shape and size drawn at data points; defaults vary by chart type.
@@ -44,17 +51,29 @@ type ChartOption struct {
 	FillArea *bool
 	// FillOpacity is the opacity or alpha channel (0-255) of the area fill in line charts.
 	FillOpacity uint8
+	// Step renders the lines of line charts as piecewise constant steps, set to LineStepBefore, LineStepAfter, or
+	// LineStepMiddle. Step can be overridden per series.
+	Step string
+	// Bands provides filled ranges rendered beneath the line series, such as confidence intervals or min/max
+	// envelopes. Bands are only rendered when the chart includes a line series.
+	Bands []BandSeries
 	// BarSize sets each bar's thickness as a ratio of the slot space allotted to it
 	// (0.0–1.0, auto by default), scaling width for vertical bars and height for horizontal bars.
 	BarSize float64
 	// BarMargin sets the spacing between grouped bars as a ratio of the category slot
 	// (0.0–1.0, auto by default). BarSize takes priority over margin.
 	BarMargin *float64
+	// BubbleSize configures the radius scale and size legend for scatter series which provide Sizes.
+	BubbleSize BubbleSizeOption
 
 	// TODO - ChartOption does not yet expose violin-specific options: ShowSpine, SpineWidth, ViolinWidth
 
 	// Radius is the target radius for pie and radar charts. Default is "40%".
 	Radius string
+	// RoseType renders pie charts as a Nightingale rose, set to PieRoseTypeRadius or PieRoseTypeArea.
+	RoseType string
+	// InnerRadius sets the radius of an empty center for pie charts.
+	InnerRadius string
 	// Children are child charts to render together.
 	Children []ChartOption
 	parent   *Painter
@@ -334,3 +353,26 @@ func ViolinRender(values [][][2]float64, opts ...OptionFunc) (*Painter, error) {
 		SeriesList: NewSeriesListViolin(values).ToGenericSeriesList(),
 	}, opts...)
 }
+
+// BoxPlotRender renders a box plot chart from raw samples, one box per sample set.
+func BoxPlotRender(samples [][]float64, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SeriesList: NewSeriesListBoxPlotWithSamples(samples).ToGenericSeriesList(),
+	}, opts...)
+}
+
+// WaterfallRender renders a waterfall chart from incremental changes. The totalIndexes mark the values rendered
+// as subtotal or total bars.
+func WaterfallRender(values [][]float64, totalIndexes []int, opts ...OptionFunc) (*Painter, error) {
+	return Render(ChartOption{
+		SeriesList: NewSeriesListWaterfall(values, WaterfallSeriesOption{
+			TotalIndexes: totalIndexes,
+		}).ToGenericSeriesList(),
+	}, opts...)
+}
+
+// ParetoRender renders a Pareto chart, with the category values sorted into descending bars and the cumulative
+// percentage drawn as a line on a secondary axis. See NewParetoChartOption for details.
+func ParetoRender(labels []string, values []float64, opts ...OptionFunc) (*Painter, error) {
+	return Render(NewParetoChartOption(labels, values), opts...)
+}
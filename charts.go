@@ -612,6 +612,11 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if err := opt.fillDefault(); err != nil {
 		return nil, err
 	}
+	stackPercent := flagIs(true, opt.StackPercent)
+	if stackPercent {
+		opt.StackSeries = Ptr(true)
+		opt.SeriesList = opt.SeriesList.stackPercent(flagIs(true, opt.StackPercentRawLabels))
+	}
 
 	isChild := opt.parent != nil
 	if !isChild {
@@ -641,6 +646,8 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	funnelSeriesList := filterSeriesList[FunnelSeriesList](opt.SeriesList, ChartTypeFunnel)
 	violinSeriesList := filterSeriesList[ViolinSeriesList](opt.SeriesList, ChartTypeViolin)
 	horizontalViolinSeriesList := filterSeriesList[ViolinSeriesList](opt.SeriesList, ChartTypeHorizontalViolin)
+	boxPlotSeriesList := filterSeriesList[BoxPlotSeriesList](opt.SeriesList, ChartTypeBoxPlot)
+	waterfallSeriesList := filterSeriesList[WaterfallSeriesList](opt.SeriesList, ChartTypeWaterfall)
 
 	// Check if any incompatible chart types are being mixed
 	// All compatible chart types need the absIndex field in the series
@@ -659,6 +666,10 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		return nil, errors.New("violin can not mix other charts")
 	} else if len(horizontalViolinSeriesList) != 0 && len(horizontalViolinSeriesList) != seriesCount {
 		return nil, errors.New("horizontal violin can not mix other charts")
+	} else if len(boxPlotSeriesList) != 0 && len(boxPlotSeriesList) != seriesCount {
+		return nil, errors.New("box plot can not mix other charts")
+	} else if len(waterfallSeriesList) != 0 && len(waterfallSeriesList) != seriesCount {
+		return nil, errors.New("waterfall can not mix other charts")
 	}
 
 	// boundary gap must be resolved here as it's shared between the axis and the chart handlers
@@ -669,7 +680,8 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 				opt.XAxis.BoundaryGap = Ptr(true) // align points to the bar / candle slot centers
 			}
 		} else if len(scatterSeriesList) != 0 {
-			opt.XAxis.BoundaryGap = Ptr(false)
+			// bubbles are centered in the category slots, so the first and last bubbles are within the plot
+			opt.XAxis.BoundaryGap = Ptr(newBubbleScale(scatterSeriesList, opt.BubbleSize).maxSize > 0)
 		}
 	}
 
@@ -765,6 +777,36 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		renderOpt.valueAxis = []ValueAxisOption{valAxis}
 		renderOpt.categoryY = false
 	}
+	if len(lineSeriesList) != 0 && len(opt.Bands) != 0 {
+		renderOpt.seriesList = lineBandSeriesList{seriesList: renderOpt.seriesList, bands: opt.Bands}
+	}
+	var scatter *scatterChart
+	if len(scatterSeriesList) != 0 {
+		// the scatter chart is created before the layout so the bubble size legend can reserve its padding
+		scatter = newScatterChart(p, ScatterChartOption{
+			Theme:          opt.Theme,
+			Padding:        opt.Padding,
+			XAxis:          opt.XAxis,
+			Symbol:         opt.Symbol,
+			SeriesList:     scatterSeriesList,
+			BubbleSize:     opt.BubbleSize,
+			ValueFormatter: opt.ValueFormatter,
+		})
+		sizeScale := newBubbleScale(scatterSeriesList, opt.BubbleSize)
+		scatter.reserveSizeLegend(sizeScale)
+		renderOpt.padding = scatter.opt.Padding
+		renderOpt.seriesList = newBubbleExtentSeriesList(renderOpt.seriesList, scatterSeriesList,
+			sizeScale, p, renderOpt.padding)
+	}
+	if len(boxPlotSeriesList) != 0 {
+		catAxis := boxPlotConfigureRenderOption(boxPlotSeriesList, opt.XAxis)
+		renderOpt.categoryAxis = &catAxis
+	}
+	if stackPercent && len(renderOpt.valueAxis) != 0 &&
+		(len(barSeriesList) != 0 || len(horizontalBarSeriesList) != 0 || len(lineSeriesList) != 0) {
+		renderOpt.valueAxis = slices.Clone(renderOpt.valueAxis) // cloned so the percent axis doesn't modify YAxis
+		renderOpt.valueAxis[0] = stackPercentValueAxis(renderOpt.valueAxis[0])
+	}
 
 	renderResult, err := defaultRender(p, renderOpt)
 	if err != nil {
@@ -843,6 +885,32 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		})
 	}
 
+	// box plot chart
+	if len(boxPlotSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := newBoxPlotChart(p, BoxPlotChartOption{
+				Theme:          opt.Theme,
+				SeriesList:     boxPlotSeriesList,
+				ValueFormatter: opt.ValueFormatter,
+			}).renderChart(renderResult)
+			return err
+		})
+	}
+
+	// waterfall chart
+	if len(waterfallSeriesList) != 0 {
+		handler.Add(func() error {
+			_, err := newWaterfallChart(p, WaterfallChartOption{
+				Theme:          opt.Theme,
+				SeriesList:     waterfallSeriesList,
+				BarSize:        opt.BarSize,
+				BarMargin:      opt.BarMargin,
+				ValueFormatter: opt.ValueFormatter,
+			}).renderChart(renderResult)
+			return err
+		})
+	}
+
 	// line chart
 	if len(lineSeriesList) != 0 {
 		handler.Add(func() error {
@@ -855,6 +923,8 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 				LineStrokeWidth: opt.LineStrokeWidth,
 				FillArea:        opt.FillArea,
 				FillOpacity:     opt.FillOpacity,
+				Step:            opt.Step,
+				Bands:           opt.Bands,
 			}).renderChart(renderResult)
 			return err
 		})
@@ -863,12 +933,7 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	// scatter chart
 	if len(scatterSeriesList) != 0 {
 		handler.Add(func() error {
-			_, err := newScatterChart(p, ScatterChartOption{
-				Theme:      opt.Theme,
-				XAxis:      opt.XAxis,
-				Symbol:     opt.Symbol,
-				SeriesList: scatterSeriesList,
-			}).renderChart(renderResult)
+			_, err := scatter.renderChart(renderResult)
 			return err
 		})
 	}
@@ -877,9 +942,11 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if len(pieSeriesList) != 0 {
 		handler.Add(func() error {
 			_, err := newPieChart(p, PieChartOption{
-				Theme:      opt.Theme,
-				Radius:     opt.Radius,
-				SeriesList: pieSeriesList,
+				Theme:       opt.Theme,
+				Radius:      opt.Radius,
+				RoseType:    opt.RoseType,
+				InnerRadius: opt.InnerRadius,
+				SeriesList:  pieSeriesList,
 			}).renderChart(renderResult)
 			return err
 		})
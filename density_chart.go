@@ -0,0 +1,535 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+const (
+	// DensityBinHexagon groups the points into hexagonal cells.
+	DensityBinHexagon = "hexagon"
+	// DensityBinRectangle groups the points into rectangular cells.
+	DensityBinRectangle = "rectangle"
+
+	// DensityAggregateCount colors each cell by the number of points within it.
+	DensityAggregateCount = "count"
+	// DensityAggregateSum colors each cell by the sum of the point weights within it.
+	DensityAggregateSum = "sum"
+	// DensityAggregateMean colors each cell by the mean of the point weights within it.
+	DensityAggregateMean = "mean"
+
+	defaultDensityBinCount      = 30
+	defaultDensityContourLevels = 5
+	densityContourGridSize      = 64
+)
+
+// DensityChartOption defines the options for rendering a 2D density chart, which groups dense scatter data into
+// hexagonal or rectangular cells colored by the heat map gradient. Render the chart using Painter.DensityChart.
+type DensityChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// BaseColorIndex specifies which color from the theme palette to use as the base for the cell gradient.
+	BaseColorIndex int
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// XValues provides the x value of each point. Points with a null x or y value are ignored.
+	XValues []float64
+	// YValues provides the y value of each point, matched to XValues by index.
+	YValues []float64
+	// Weights provides a weight for each point, required for DensityAggregateSum and DensityAggregateMean.
+	Weights []float64
+	// BinShape selects the cell shape: DensityBinHexagon (default) or DensityBinRectangle.
+	BinShape string
+	// BinCount sets the number of cells across the x-axis. Cell heights are sized to keep the cells regular.
+	// Default is 30.
+	BinCount int
+	// Aggregate selects the cell value: DensityAggregateCount (default), DensityAggregateSum, or
+	// DensityAggregateMean.
+	Aggregate string
+	// ScaleMinValue overrides the minimum cell value for the color gradient. If nil, calculated from the cells.
+	ScaleMinValue *float64
+	// ScaleMaxValue overrides the maximum cell value for the color gradient. If nil, calculated from the cells.
+	ScaleMaxValue *float64
+	// ShowContours when *true overlays iso-density contour lines from a 2D Gaussian kernel density estimate.
+	ShowContours *bool
+	// ContourLevels sets the number of contour lines, evenly spaced between zero and the peak density. Default is 5.
+	ContourLevels int
+	// ContourBandwidthScale multiplies the KDE bandwidth from Scott's rule, larger values produce smoother contours.
+	// Default is 1.
+	ContourBandwidthScale float64
+	// ContourColor sets the color of the contour lines. Default is the theme label text color.
+	ContourColor Color
+	// ContourStrokeWidth is the stroke width of the contour lines. Default is 1.
+	ContourStrokeWidth float64
+	// XAxis contains options for the numeric x-axis. Labels are generated from the x value range.
+	XAxis XAxisOption
+	// YAxis contains options for the y-axis.
+	YAxis YAxisOption
+	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
+	ValueFormatter ValueFormatter
+}
+
+type densityChart struct {
+	p   *Painter
+	opt *DensityChartOption
+}
+
+// newDensityChart returns a density chart renderer.
+func newDensityChart(p *Painter, opt DensityChartOption) *densityChart {
+	return &densityChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewDensityChartOptionWithData returns an initialized DensityChartOption for the provided point coordinates.
+func NewDensityChartOptionWithData(xValues, yValues []float64) DensityChartOption {
+	return DensityChartOption{
+		XValues:        xValues,
+		YValues:        yValues,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// densityBin accumulates the points within a single cell.
+type densityBin struct {
+	col, row int
+	count    int
+	sum      float64
+}
+
+// value returns the aggregated cell value.
+func (b *densityBin) value(aggregate string) float64 {
+	switch aggregate {
+	case DensityAggregateSum:
+		return b.sum
+	case DensityAggregateMean:
+		return b.sum / float64(b.count)
+	default:
+		return float64(b.count)
+	}
+}
+
+// densityGrid maps pixel positions to cells of the configured shape.
+type densityGrid struct {
+	hexagon bool
+	// cellWidth and cellHeight are the spacing between cell centers.
+	cellWidth, cellHeight float64
+	// radius is the hexagon center to vertex distance.
+	radius float64
+}
+
+// newDensityGrid returns a grid with binCount cells across the width, sizing the rows to keep the cells regular.
+func newDensityGrid(hexagon bool, width, height, binCount int) densityGrid {
+	cellWidth := float64(width) / float64(binCount)
+	if hexagon {
+		radius := cellWidth / math.Sqrt(3)
+		return densityGrid{hexagon: true, cellWidth: cellWidth, cellHeight: radius * 1.5, radius: radius}
+	}
+	rows := max(1, int(math.Round(float64(height)/cellWidth)))
+	return densityGrid{cellWidth: cellWidth, cellHeight: float64(height) / float64(rows)}
+}
+
+// cell returns the column and row of the cell containing the pixel position.
+func (g densityGrid) cell(x, y float64) (int, int) {
+	if !g.hexagon {
+		return int(x / g.cellWidth), int(y / g.cellHeight)
+	}
+	// nearest hexagon center, odd rows are offset by half a cell
+	py := y / g.cellHeight
+	pj := math.Round(py)
+	px := x/g.cellWidth - float64(int(pj)&1)/2
+	pi := math.Round(px)
+	py1 := py - pj
+	if math.Abs(py1)*3 > 1 { // near the slanted edges, compare against the neighboring row
+		px1 := px - pi
+		pi2 := pi + math.Copysign(0.5, px-pi)
+		pj2 := pj + math.Copysign(1, py-pj)
+		px2 := px - pi2
+		py2 := py - pj2
+		if math.Hypot(px1*g.cellWidth, py1*g.cellHeight) > math.Hypot(px2*g.cellWidth, py2*g.cellHeight) {
+			pi = pi2 - 0.5
+			if int(pj)&1 == 1 {
+				pi = pi2 + 0.5
+			}
+			pj = pj2
+		}
+	}
+	return int(pi), int(pj)
+}
+
+// outline returns the polygon of the cell, clipped to the width and height.
+func (g densityGrid) outline(col, row int, width, height float64) [][2]float64 {
+	var points [][2]float64
+	if g.hexagon {
+		cx := (float64(col) + float64(row&1)/2) * g.cellWidth
+		cy := float64(row) * g.cellHeight
+		for i := 0; i < 6; i++ {
+			angle := float64(i) * math.Pi / 3
+			points = append(points, [2]float64{cx + g.radius*math.Sin(angle), cy - g.radius*math.Cos(angle)})
+		}
+	} else {
+		x1, y1 := float64(col)*g.cellWidth, float64(row)*g.cellHeight
+		x2, y2 := x1+g.cellWidth, y1+g.cellHeight
+		points = [][2]float64{{x1, y1}, {x2, y1}, {x2, y2}, {x1, y2}}
+	}
+	return clipPolygonToRect(points, width, height)
+}
+
+// clipPolygonToRect clips a convex polygon to the rectangle from the origin to the width and height.
+func clipPolygonToRect(points [][2]float64, width, height float64) [][2]float64 {
+	edges := []struct {
+		axis  int
+		limit float64
+		upper bool
+	}{{0, 0, false}, {0, width, true}, {1, 0, false}, {1, height, true}}
+	for _, edge := range edges {
+		inside := func(pt [2]float64) bool {
+			if edge.upper {
+				return pt[edge.axis] <= edge.limit
+			}
+			return pt[edge.axis] >= edge.limit
+		}
+		var result [][2]float64
+		for i, cur := range points {
+			prev := points[(i+len(points)-1)%len(points)]
+			if inside(cur) != inside(prev) {
+				t := (edge.limit - prev[edge.axis]) / (cur[edge.axis] - prev[edge.axis])
+				result = append(result, [2]float64{prev[0] + t*(cur[0]-prev[0]), prev[1] + t*(cur[1]-prev[1])})
+			}
+			if inside(cur) {
+				result = append(result, cur)
+			}
+		}
+		points = result
+		if len(points) == 0 {
+			break
+		}
+	}
+	return points
+}
+
+// densityContourSegments returns the contour line segments for the density grid, as pairs of points in grid
+// coordinates, using marching squares.
+func densityContourSegments(grid [][]float64, level float64) [][2][2]float64 {
+	var segments [][2][2]float64
+	interpolate := func(x1, y1, v1, x2, y2, v2 float64) [2]float64 {
+		t := (level - v1) / (v2 - v1)
+		return [2]float64{x1 + t*(x2-x1), y1 + t*(y2-y1)}
+	}
+	for y := 0; y+1 < len(grid); y++ {
+		for x := 0; x+1 < len(grid[y]); x++ {
+			// corners clockwise from the top left
+			tl, tr, br, bl := grid[y][x], grid[y][x+1], grid[y+1][x+1], grid[y+1][x]
+			var caseIndex int
+			for i, v := range []float64{tl, tr, br, bl} {
+				if v >= level {
+					caseIndex |= 1 << i
+				}
+			}
+			if caseIndex == 0 || caseIndex == 15 {
+				continue
+			}
+			fx, fy := float64(x), float64(y)
+			top := interpolate(fx, fy, tl, fx+1, fy, tr)
+			right := interpolate(fx+1, fy, tr, fx+1, fy+1, br)
+			bottom := interpolate(fx, fy+1, bl, fx+1, fy+1, br)
+			left := interpolate(fx, fy, tl, fx, fy+1, bl)
+			switch caseIndex {
+			case 1, 14:
+				segments = append(segments, [2][2]float64{left, top})
+			case 2, 13:
+				segments = append(segments, [2][2]float64{top, right})
+			case 3, 12:
+				segments = append(segments, [2][2]float64{left, right})
+			case 4, 11:
+				segments = append(segments, [2][2]float64{right, bottom})
+			case 6, 9:
+				segments = append(segments, [2][2]float64{top, bottom})
+			case 7, 8:
+				segments = append(segments, [2][2]float64{left, bottom})
+			case 5, 10: // saddle, resolved by the cell center value
+				centerAbove := (tl+tr+br+bl)/4 >= level
+				if (caseIndex == 5) == centerAbove {
+					segments = append(segments, [2][2]float64{left, bottom}, [2][2]float64{top, right})
+				} else {
+					segments = append(segments, [2][2]float64{left, top}, [2][2]float64{right, bottom})
+				}
+			}
+		}
+	}
+	return segments
+}
+
+// densityKDEGrid returns a size x size grid of the 2D Gaussian kernel density estimate of the pixel positions.
+// Points are first binned to the grid so large data sets are estimated with a separable convolution.
+func densityKDEGrid(points [][2]float64, width, height float64, size int, bandwidthScale float64) [][]float64 {
+	if len(points) < 2 {
+		return nil
+	}
+	xs, ys := make([]float64, len(points)), make([]float64, len(points))
+	counts := make([][]float64, size)
+	for i := range counts {
+		counts[i] = make([]float64, size)
+	}
+	scaleX, scaleY := float64(size-1)/width, float64(size-1)/height
+	for i, pt := range points {
+		xs[i], ys[i] = pt[0]*scaleX, pt[1]*scaleY
+		col := min(max(int(math.Round(xs[i])), 0), size-1)
+		row := min(max(int(math.Round(ys[i])), 0), size-1)
+		counts[row][col]++
+	}
+	// Scott's rule for two dimensions, in grid units
+	factor := math.Pow(float64(len(points)), -1.0/6) * bandwidthScale
+	bandwidthX := summarizePopulationData(xs).StandardDeviation * factor
+	bandwidthY := summarizePopulationData(ys).StandardDeviation * factor
+	if bandwidthX <= 0 || bandwidthY <= 0 {
+		return nil
+	}
+
+	kernel := func(bandwidth float64) []float64 {
+		radius := min(int(math.Ceil(bandwidth*3)), size-1)
+		weights := make([]float64, 2*radius+1)
+		for i := range weights {
+			d := float64(i-radius) / bandwidth
+			weights[i] = math.Exp(-0.5 * d * d)
+		}
+		return weights
+	}
+	convolve := func(get func(int) float64, set func(int, float64), weights []float64) {
+		radius := len(weights) / 2
+		for i := 0; i < size; i++ {
+			var sum float64
+			for k, w := range weights {
+				if j := i + k - radius; j >= 0 && j < size {
+					sum += get(j) * w
+				}
+			}
+			set(i, sum)
+		}
+	}
+	kernelX, kernelY := kernel(bandwidthX), kernel(bandwidthY)
+	smoothed := make([][]float64, size)
+	for row := range counts {
+		smoothed[row] = make([]float64, size)
+		convolve(func(j int) float64 { return counts[row][j] },
+			func(i int, v float64) { smoothed[row][i] = v }, kernelX)
+	}
+	column := make([]float64, size)
+	for col := 0; col < size; col++ {
+		for row := range smoothed {
+			column[row] = smoothed[row][col]
+		}
+		convolve(func(j int) float64 { return column[j] },
+			func(i int, v float64) { counts[i][col] = v }, kernelY)
+	}
+	return counts
+}
+
+func (d *densityChart) renderChart(result *defaultRenderResult, xMin, xMax float64) (Box, error) {
+	p := d.p
+	opt := d.opt
+	seriesPainter := result.seriesPainter
+	yRange := result.valueAxisRanges[0]
+	width, height := float64(seriesPainter.Width()), float64(seriesPainter.Height())
+	if width <= 0 || height <= 0 {
+		return BoxZero, errors.New("insufficient space for density chart")
+	}
+
+	pointCount := min(len(opt.XValues), len(opt.YValues))
+	points := make([][2]float64, 0, pointCount)
+	weights := make([]float64, 0, pointCount)
+	for i := 0; i < pointCount; i++ {
+		x, y := opt.XValues[i], opt.YValues[i]
+		if !isValidExtent(x) || !isValidExtent(y) || y < yRange.min || y > yRange.max {
+			continue
+		}
+		points = append(points, [2]float64{
+			(x - xMin) / (xMax - xMin) * width,
+			height - (y-yRange.min)/(yRange.max-yRange.min)*height,
+		})
+		if i < len(opt.Weights) {
+			weights = append(weights, opt.Weights[i])
+		} else {
+			weights = append(weights, 0)
+		}
+	}
+	if len(points) == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+
+	binCount := opt.BinCount
+	if binCount <= 0 {
+		binCount = defaultDensityBinCount
+	}
+	grid := newDensityGrid(opt.BinShape != DensityBinRectangle, seriesPainter.Width(), seriesPainter.Height(), binCount)
+	binIndex := make(map[[2]int]int)
+	var bins []*densityBin
+	for i, pt := range points {
+		col, row := grid.cell(min(pt[0], width-1), min(pt[1], height-1))
+		key := [2]int{col, row}
+		index, ok := binIndex[key]
+		if !ok {
+			index = len(bins)
+			binIndex[key] = index
+			bins = append(bins, &densityBin{col: col, row: row})
+		}
+		bins[index].count++
+		bins[index].sum += weights[i]
+	}
+	slices.SortFunc(bins, func(a, b *densityBin) int {
+		if a.row != b.row {
+			return a.row - b.row
+		}
+		return a.col - b.col
+	})
+
+	minVal, maxVal := math.MaxFloat64, -math.MaxFloat64
+	for _, bin := range bins {
+		v := bin.value(opt.Aggregate)
+		minVal, maxVal = min(minVal, v), max(maxVal, v)
+	}
+	if opt.ScaleMinValue != nil {
+		minVal = *opt.ScaleMinValue
+	}
+	if opt.ScaleMaxValue != nil {
+		maxVal = *opt.ScaleMaxValue
+	}
+	baseColor := opt.Theme.GetSeriesColor(opt.BaseColorIndex)
+	for _, bin := range bins {
+		ratio := 1.0
+		if maxVal > minVal {
+			ratio = min(max((bin.value(opt.Aggregate)-minVal)/(maxVal-minVal), 0), 1)
+		}
+		outline := grid.outline(bin.col, bin.row, width, height)
+		if len(outline) < 3 {
+			continue
+		}
+		polygon := make([]Point, len(outline)+1)
+		for i, pt := range outline {
+			polygon[i] = Point{X: int(math.Round(pt[0])), Y: int(math.Round(pt[1]))}
+		}
+		polygon[len(outline)] = polygon[0]
+		seriesPainter.FillArea(polygon, heatMapCellColor(baseColor, ratio, opt.Theme.IsDark()))
+	}
+
+	if flagIs(true, opt.ShowContours) {
+		d.renderContours(seriesPainter, points, width, height)
+	}
+	return p.box, nil
+}
+
+// renderContours draws the iso-density lines of the point pixel positions.
+func (d *densityChart) renderContours(seriesPainter *Painter, points [][2]float64, width, height float64) {
+	opt := d.opt
+	bandwidthScale := opt.ContourBandwidthScale
+	if bandwidthScale <= 0 {
+		bandwidthScale = 1
+	}
+	kde := densityKDEGrid(points, width, height, densityContourGridSize, bandwidthScale)
+	var peak float64
+	for _, row := range kde {
+		for _, v := range row {
+			peak = max(peak, v)
+		}
+	}
+	if peak <= 0 {
+		return
+	}
+	levels := opt.ContourLevels
+	if levels <= 0 {
+		levels = defaultDensityContourLevels
+	}
+	color := opt.ContourColor
+	if color.IsZero() {
+		color = opt.Theme.GetLabelTextColor()
+	}
+	strokeWidth := opt.ContourStrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1
+	}
+	scaleX := width / float64(densityContourGridSize-1)
+	scaleY := height / float64(densityContourGridSize-1)
+	for level := 1; level <= levels; level++ {
+		segments := densityContourSegments(kde, peak*float64(level)/float64(levels+1))
+		linePoints := make([]Point, 0, len(segments)*3)
+		for _, segment := range segments {
+			for _, pt := range segment {
+				linePoints = append(linePoints,
+					Point{X: int(math.Round(pt[0] * scaleX)), Y: int(math.Round(pt[1] * scaleY))})
+			}
+			linePoints = append(linePoints, Point{Y: math.MaxInt32}) // break between segments
+		}
+		if len(linePoints) > 0 {
+			seriesPainter.LineStroke(linePoints, color, strokeWidth)
+		}
+	}
+}
+
+func (d *densityChart) Render() (Box, error) {
+	p := d.p
+	opt := d.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.BinShape != "" && opt.BinShape != DensityBinHexagon && opt.BinShape != DensityBinRectangle {
+		return BoxZero, fmt.Errorf("unsupported density BinShape %q", opt.BinShape)
+	}
+	switch opt.Aggregate {
+	case "", DensityAggregateCount:
+	case DensityAggregateSum, DensityAggregateMean:
+		if len(opt.Weights) < min(len(opt.XValues), len(opt.YValues)) {
+			return BoxZero, fmt.Errorf("density Aggregate %q requires a weight for each point", opt.Aggregate)
+		}
+	default:
+		return BoxZero, fmt.Errorf("unsupported density Aggregate %q", opt.Aggregate)
+	}
+
+	pointCount := min(len(opt.XValues), len(opt.YValues))
+	xMin, xMax := math.MaxFloat64, -math.MaxFloat64
+	yMin, yMax := math.MaxFloat64, -math.MaxFloat64
+	for i := 0; i < pointCount; i++ {
+		if x, y := opt.XValues[i], opt.YValues[i]; isValidExtent(x) && isValidExtent(y) {
+			xMin, xMax = min(xMin, x), max(xMax, x)
+			yMin, yMax = min(yMin, y), max(yMax, y)
+		}
+	}
+	var yValues []float64
+	if xMin > xMax {
+		xMin, xMax = 0, 1 // no data
+	} else {
+		yValues = []float64{yMin, yMax}
+	}
+
+	// x values are labeled on tick marks, producing a numeric axis spanning the labels
+	xAxis := opt.XAxis
+	xAxis.BoundaryGap = Ptr(false)
+	xLabels := niceAxisLabels(xMin, xMax, 5)
+	xAxis.Labels = make([]string, len(xLabels))
+	xFormatter := getPreferredValueFormatter(opt.XAxis.ValueFormatter, opt.ValueFormatter)
+	for i, v := range xLabels {
+		xAxis.Labels[i] = xFormatter(v)
+	}
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:          opt.Theme,
+		padding:        opt.Padding,
+		seriesList:     valuesFakeSeries{values: yValues},
+		categoryAxis:   &xAxis,
+		valueAxis:      []ValueAxisOption{opt.YAxis},
+		title:          opt.Title,
+		legend:         &LegendOption{Show: Ptr(false)},
+		valueFormatter: opt.ValueFormatter,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return d.renderChart(renderResult, xLabels[0], xLabels[len(xLabels)-1])
+}
@@ -0,0 +1,226 @@
+package charts
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeDensityTestData(count int) ([]float64, []float64) {
+	r := rand.New(rand.NewSource(1))
+	xValues, yValues := make([]float64, count), make([]float64, count)
+	for i := range xValues {
+		if i%3 == 0 {
+			xValues[i], yValues[i] = r.NormFloat64()*10+30, r.NormFloat64()*8+60
+		} else {
+			xValues[i], yValues[i] = r.NormFloat64()*15+70, r.NormFloat64()*12+30
+		}
+	}
+	return xValues, yValues
+}
+
+func TestDensityGridCell(t *testing.T) {
+	t.Parallel()
+
+	hexGrid := newDensityGrid(true, 100, 100, 10)
+	// every position maps to the hexagon with the nearest center
+	for x := 0.0; x < 100; x += 3.7 {
+		for y := 0.0; y < 100; y += 2.9 {
+			col, row := hexGrid.cell(x, y)
+			cx := (float64(col) + float64(row&1)/2) * hexGrid.cellWidth
+			cy := float64(row) * hexGrid.cellHeight
+			distance := math.Hypot(x-cx, y-cy)
+			for _, dr := range []int{-1, 0, 1} {
+				for _, dc := range []int{-1, 0, 1} {
+					r, c := row+dr, col+dc
+					ox := (float64(c) + float64(r&1)/2) * hexGrid.cellWidth
+					oy := float64(r) * hexGrid.cellHeight
+					assert.LessOrEqual(t, distance, math.Hypot(x-ox, y-oy)+1e-9, "x=%f y=%f", x, y)
+				}
+			}
+		}
+	}
+
+	rectGrid := newDensityGrid(false, 100, 44, 10)
+	assert.InDelta(t, 10.0, rectGrid.cellWidth, 0)
+	assert.InDelta(t, 11.0, rectGrid.cellHeight, 0)
+	col, row := rectGrid.cell(25, 43)
+	assert.Equal(t, 2, col)
+	assert.Equal(t, 3, row)
+}
+
+func TestClipPolygonToRect(t *testing.T) {
+	t.Parallel()
+
+	inside := [][2]float64{{1, 1}, {5, 1}, {5, 5}}
+	assert.Equal(t, inside, clipPolygonToRect(inside, 10, 10))
+
+	clipped := clipPolygonToRect([][2]float64{{-5, -5}, {5, -5}, {5, 5}, {-5, 5}}, 10, 10)
+	assert.ElementsMatch(t, [][2]float64{{0, 0}, {5, 0}, {5, 5}, {0, 5}}, clipped)
+
+	assert.Empty(t, clipPolygonToRect([][2]float64{{-5, -5}, {-1, -5}, {-1, -1}}, 10, 10))
+}
+
+func TestDensityContourSegments(t *testing.T) {
+	t.Parallel()
+
+	grid := [][]float64{
+		{0, 0, 0},
+		{0, 4, 0},
+		{0, 0, 0},
+	}
+	segments := densityContourSegments(grid, 2)
+	require.Len(t, segments, 4)
+	for _, segment := range segments {
+		for _, pt := range segment {
+			// each crossing is halfway between the peak and its neighbors
+			assert.InDelta(t, 0.5, math.Abs(pt[0]-1)+math.Abs(pt[1]-1), 1e-9)
+		}
+	}
+	assert.Empty(t, densityContourSegments(grid, 5))
+}
+
+func TestDensityKDEGrid(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, densityKDEGrid([][2]float64{{1, 1}}, 10, 10, 8, 1))
+
+	points := [][2]float64{{2, 2}, {3, 3}, {3, 2}, {2, 3}, {8, 8}}
+	grid := densityKDEGrid(points, 10, 10, 11, 1)
+	require.Len(t, grid, 11)
+	assert.Greater(t, grid[2][2], grid[8][8])
+	assert.Greater(t, grid[8][8], grid[0][10])
+}
+
+func TestDensityChartLargeValues(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	assert.NoError(t, p.DensityChart(NewDensityChartOptionWithData(
+		[]float64{1e20, math.Nextafter(1e20, math.Inf(1)), 1e20}, []float64{1, 2, 3})))
+}
+
+func TestDensityChartError(t *testing.T) {
+	t.Parallel()
+
+	xValues, yValues := makeDensityTestData(10)
+	tests := []struct {
+		name      string
+		option    func(*DensityChartOption)
+		errString string
+	}{
+		{
+			name:      "bin_shape",
+			option:    func(o *DensityChartOption) { o.BinShape = "triangle" },
+			errString: `unsupported density BinShape "triangle"`,
+		},
+		{
+			name:      "aggregate",
+			option:    func(o *DensityChartOption) { o.Aggregate = "max" },
+			errString: `unsupported density Aggregate "max"`,
+		},
+		{
+			name:      "missing_weights",
+			option:    func(o *DensityChartOption) { o.Aggregate = DensityAggregateSum; o.Weights = []float64{1} },
+			errString: `density Aggregate "sum" requires a weight for each point`,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			opt := NewDensityChartOptionWithData(xValues, yValues)
+			tt.option(&opt)
+			err := p.DensityChart(opt)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.errString)
+		})
+	}
+}
+
+func TestDensityChart(t *testing.T) {
+	t.Parallel()
+
+	xValues, yValues := makeDensityTestData(400)
+	tests := []struct {
+		name   string
+		option func(*DensityChartOption)
+	}{
+		{
+			name:   "hexagon",
+			option: func(o *DensityChartOption) {},
+		},
+		{
+			name: "hexagon_contours",
+			option: func(o *DensityChartOption) {
+				o.Title.Text = "Density"
+				o.BinCount = 12
+				o.ShowContours = Ptr(true)
+				o.ContourLevels = 3
+			},
+		},
+		{
+			name: "rectangle_contours_dark",
+			option: func(o *DensityChartOption) {
+				o.Theme = GetTheme(ThemeDark)
+				o.BinShape = DensityBinRectangle
+				o.BinCount = 15
+				o.ShowContours = Ptr(true)
+				o.ContourBandwidthScale = 2
+				o.ContourColor = ColorRed
+				o.ContourStrokeWidth = 2
+			},
+		},
+		{
+			name: "mean_weights",
+			option: func(o *DensityChartOption) {
+				o.BinCount = 12
+				o.Aggregate = DensityAggregateMean
+				o.Weights = o.XValues
+				o.BaseColorIndex = 2
+				o.YAxis.Min = Ptr(0.0)
+				o.YAxis.Max = Ptr(80.0)
+			},
+		},
+		{
+			name: "scale_override",
+			option: func(o *DensityChartOption) {
+				o.BinShape = DensityBinRectangle
+				o.BinCount = 10
+				o.ScaleMinValue = Ptr(0.0)
+				o.ScaleMaxValue = Ptr(100.0)
+			},
+		},
+		{
+			name: "no_data",
+			option: func(o *DensityChartOption) {
+				o.XValues = []float64{GetNullValue()}
+				o.YValues = []float64{1}
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			opt := NewDensityChartOptionWithData(xValues, yValues)
+			tt.option(&opt)
+			require.NoError(t, p.DensityChart(opt))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package charts
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/go-analyze/bulk"
+)
+
+// divergingBarAxisIntervals is the target number of label intervals on each half of the value axis.
+const divergingBarAxisIntervals = 4
+
+// DivergingBarSeries provides the values for one side of a diverging bar chart.
+type DivergingBarSeries struct {
+	// Name specifies the series name, shown in the legend.
+	Name string
+	// Values provides the value for each category. Bars are sized by the absolute value, so values may be provided
+	// as either positive or negative numbers. Null values are not rendered.
+	Values []float64
+}
+
+// DivergingBarChartOption defines the options for rendering a diverging horizontal bar chart, such as a population
+// pyramid. The bars of two series extend left and right from a shared center axis, with the value axis and labels
+// showing absolute values. Render the chart using Painter.DivergingBarChart.
+type DivergingBarChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the data legend.
+	Legend LegendOption
+	// LeftSeries provides the values for the bars extending left of the center axis.
+	LeftSeries DivergingBarSeries
+	// RightSeries provides the values for the bars extending right of the center axis.
+	RightSeries DivergingBarSeries
+	// Categories specifies the category names, the first rendered at the bottom. Default is the category number.
+	Categories []string
+	// CenterLabels when *true renders the category names in a gutter between the two sides, rather than to the
+	// left of the chart.
+	CenterLabels *bool
+	// Max sets the value at the outer edges of the axis, shared by both sides. If nil, calculated from the data.
+	Max *float64
+	// BarSize sets each bar's thickness as a ratio of the category slot (0.0–1.0, auto by default).
+	BarSize float64
+	// AxisFontStyle specifies the font for the category names and the value axis labels.
+	AxisFontStyle FontStyle
+	// Label configures the value labels rendered beyond the end of each bar. Set Show to *false to hide.
+	Label SeriesLabel
+	// ValueFormatter defines how float values are rendered to strings for the axis and labels.
+	ValueFormatter ValueFormatter
+}
+
+type divergingBarChart struct {
+	p   *Painter
+	opt *DivergingBarChartOption
+}
+
+// newDivergingBarChart returns a diverging bar chart renderer.
+func newDivergingBarChart(p *Painter, opt DivergingBarChartOption) *divergingBarChart {
+	return &divergingBarChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewDivergingBarChartOptionWithData returns an initialized DivergingBarChartOption with the values for the left
+// and right series.
+func NewDivergingBarChartOptionWithData(leftValues, rightValues []float64) DivergingBarChartOption {
+	return DivergingBarChartOption{
+		LeftSeries:     DivergingBarSeries{Values: leftValues},
+		RightSeries:    DivergingBarSeries{Values: rightValues},
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+func (d *divergingBarChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := d.p
+	opt := d.opt
+	seriesPainter := result.seriesPainter
+	sides := []DivergingBarSeries{opt.LeftSeries, opt.RightSeries}
+	categoryCount := max(len(opt.LeftSeries.Values), len(opt.RightSeries.Values))
+	var maxValue float64
+	var hasValue bool
+	for _, series := range sides {
+		for _, v := range series.Values {
+			if isValidExtent(v) {
+				maxValue = max(maxValue, math.Abs(v))
+				hasValue = true
+			}
+		}
+	}
+	if !hasValue {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	if opt.Max != nil && *opt.Max != 0 {
+		maxValue = math.Abs(*opt.Max)
+	} else if maxValue == 0 {
+		maxValue = 1 // all values are zero
+	}
+
+	valueFormatter := getPreferredValueFormatter(opt.ValueFormatter)
+	ticks := niceAxisLabels(0, maxValue, divergingBarAxisIntervals)
+	if opt.Max != nil { // the configured max is the axis edge, drop ticks beyond it
+		ticks = bulk.SliceFilter(func(v float64) bool { return v <= maxValue }, ticks)
+	}
+	axisMax := max(ticks[len(ticks)-1], maxValue)
+	tickLabels := make([]string, len(ticks))
+	for i, v := range ticks {
+		tickLabels[i] = valueFormatter(v)
+	}
+	categories := make([]string, categoryCount)
+	for i := range categories {
+		if i < len(opt.Categories) {
+			categories[i] = opt.Categories[i]
+		} else {
+			categories[i] = strconv.Itoa(i + 1)
+		}
+	}
+
+	axisStyle := fillFontStyleDefaults(opt.AxisFontStyle, defaultFontSize, opt.Theme.GetXAxisTextColor(),
+		seriesPainter.font)
+	const labelMargin = 8
+	categoryWidth, _ := seriesPainter.measureTextMaxWidthHeight(categories, 0, axisStyle)
+	_, tickHeight := seriesPainter.measureTextMaxWidthHeight(tickLabels, 0, axisStyle)
+	edgeWidth := seriesPainter.MeasureText(tickLabels[len(tickLabels)-1], 0, axisStyle).Width() / 2
+	centerLabels := flagIs(true, opt.CenterLabels)
+	left, right := edgeWidth, seriesPainter.Width()-edgeWidth
+	var gutter int
+	if centerLabels {
+		gutter = categoryWidth + 2*labelMargin
+	} else {
+		left = max(left, categoryWidth+labelMargin)
+	}
+	halfWidth := (right - left - gutter) / 2
+	// leftBase and rightBase are the x positions the bars grow from
+	leftBase := left + halfWidth
+	rightBase := leftBase + gutter
+	bottom := seriesPainter.Height() - tickHeight - labelMargin
+	if halfWidth < 10 || bottom < categoryCount {
+		return BoxZero, errors.New("insufficient space for diverging bar chart")
+	}
+	barLength := func(v float64) int {
+		return int(math.Round(min(math.Abs(v), axisMax) / axisMax * float64(halfWidth)))
+	}
+
+	// split lines and tick labels mirrored on both sides
+	splitColor := opt.Theme.GetAxisSplitLineColor()
+	axisColor := opt.Theme.GetXAxisStrokeColor()
+	for i, v := range ticks {
+		textBox := seriesPainter.MeasureText(tickLabels[i], 0, axisStyle)
+		for side, x := range []int{leftBase - barLength(v), rightBase + barLength(v)} {
+			if i == 0 && side == 1 && gutter == 0 {
+				continue // the sides share the zero tick
+			} else if i != 0 {
+				seriesPainter.LineStroke([]Point{{X: x, Y: 0}, {X: x, Y: bottom}}, splitColor, 1)
+			}
+			seriesPainter.LineStroke([]Point{{X: x, Y: bottom}, {X: x, Y: bottom + 4}}, axisColor, 1)
+			seriesPainter.Text(tickLabels[i], x-textBox.Width()/2, bottom+labelMargin+textBox.Height(), 0, axisStyle)
+		}
+	}
+	seriesPainter.LineStroke([]Point{{X: left, Y: bottom}, {X: leftBase, Y: bottom}}, axisColor, 1)
+	seriesPainter.LineStroke([]Point{{X: rightBase, Y: bottom}, {X: right, Y: bottom}}, axisColor, 1)
+
+	divideValues := autoDivide(bottom, categoryCount)
+	margin, _, barHeight := calculateGroupMarginsAndSize(1, bottom/categoryCount,
+		resolveBarSizePixels(opt.BarSize, bottom/categoryCount, 1), nil)
+	showLabels := !flagIs(false, opt.Label.Show)
+	// the label color is resolved per label, as labels moved inside the bar contrast with the bar color
+	labelStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize, Color{}, seriesPainter.font)
+	labelFormatter := getPreferredValueFormatter(opt.Label.ValueFormatter, valueFormatter)
+	for j, category := range categories {
+		slot := categoryCount - j - 1 // reversed so the first category is at the bottom
+		top := divideValues[slot] + margin
+		center := top + barHeight/2
+
+		nameBox := seriesPainter.MeasureText(category, 0, axisStyle)
+		nameX := left - labelMargin - nameBox.Width()
+		if centerLabels {
+			nameX = leftBase + (gutter-nameBox.Width())/2
+		}
+		seriesPainter.Text(category, nameX, center+nameBox.Height()/2, 0, axisStyle)
+
+		for seriesIndex, series := range sides {
+			if j >= len(series.Values) || !isValidExtent(series.Values[j]) {
+				continue
+			}
+			value := series.Values[j]
+			length := barLength(value)
+			color := opt.Theme.GetSeriesColor(seriesIndex)
+			x0, x1 := rightBase, rightBase+length
+			if seriesIndex == 0 {
+				x0, x1 = leftBase-length, leftBase
+			}
+			seriesPainter.FilledRect(x0, top, x1, top+barHeight, color, color, 0)
+			if !showLabels {
+				continue
+			}
+
+			text := labelFormatter(math.Abs(value))
+			fontStyle := labelStyle
+			if opt.Label.LabelFormatter != nil {
+				var style *LabelStyle
+				text, style = opt.Label.LabelFormatter(j, series.Name, value)
+				if style != nil {
+					fontStyle = mergeFontStyles(style.FontStyle, labelStyle)
+				}
+			}
+			if text == "" {
+				continue
+			}
+			// labels extend outward from the bar end, moving inside the bar where they would leave the plot
+			autoColor := fontStyle.FontColor.IsZero()
+			if autoColor {
+				fontStyle.FontColor = opt.Theme.GetLabelTextColor()
+			}
+			textBox := seriesPainter.MeasureText(text, 0, fontStyle)
+			x := x1 + 4
+			var inside bool
+			if seriesIndex == 0 {
+				x = x0 - 4 - textBox.Width()
+				if x < left {
+					x, inside = x0+4, true
+				}
+			} else if x+textBox.Width() > right {
+				x, inside = x1-4-textBox.Width(), true
+			}
+			if autoColor && inside {
+				if isLightColor(color) {
+					fontStyle.FontColor = defaultLightFontColor
+				} else {
+					fontStyle.FontColor = defaultDarkFontColor
+				}
+			}
+			seriesPainter.Text(text, x, center+textBox.Height()/2, 0, fontStyle)
+		}
+	}
+	return p.box, nil
+}
+
+func (d *divergingBarChart) Render() (Box, error) {
+	p := d.p
+	opt := d.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:   opt.Theme,
+		padding: opt.Padding,
+		seriesList: hierarchyFakeSeries{
+			chartType:   ChartTypeDivergingBar,
+			seriesNames: []string{opt.LeftSeries.Name, opt.RightSeries.Name},
+		},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title:  opt.Title,
+		legend: &opt.Legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return d.renderChart(renderResult)
+}
@@ -0,0 +1,139 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicDivergingBarChartOption() DivergingBarChartOption {
+	opt := NewDivergingBarChartOptionWithData(
+		[]float64{-2.1, -2.4, -2.8, -3.1, -2.9, -2.6, -2.2, -1.7, -1.1, -0.5},
+		[]float64{2.0, 2.3, 2.7, 3.0, 3.0, 2.8, 2.5, 2.0, 1.5, 0.9})
+	opt.LeftSeries.Name = "Male"
+	opt.RightSeries.Name = "Female"
+	opt.Categories = []string{"0-9", "10-19", "20-29", "30-39", "40-49", "50-59", "60-69", "70-79", "80-89", "90+"}
+	return opt
+}
+
+func TestNewDivergingBarChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewDivergingBarChartOptionWithData([]float64{-1, -2}, []float64{3, 4})
+
+	assert.Equal(t, []float64{-1, -2}, opt.LeftSeries.Values)
+	assert.Equal(t, []float64{3, 4}, opt.RightSeries.Values)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.Theme)
+	assert.NotNil(t, opt.ValueFormatter)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.DivergingBarChart(opt))
+}
+
+func TestDivergingBarChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() DivergingBarChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBasicDivergingBarChartOption,
+		},
+		{
+			name: "center_labels_dark",
+			makeOptions: func() DivergingBarChartOption {
+				opt := makeBasicDivergingBarChartOption()
+				opt.Theme = GetTheme(ThemeDark)
+				opt.Title.Text = "Population"
+				opt.CenterLabels = Ptr(true)
+				return opt
+			},
+		},
+		{
+			name: "max_formatter_bar_size",
+			makeOptions: func() DivergingBarChartOption {
+				opt := makeBasicDivergingBarChartOption()
+				opt.Max = Ptr(5.0)
+				opt.BarSize = 0.5
+				opt.ValueFormatter = func(f float64) string {
+					return FormatValueHumanize(f, 1, false) + "M"
+				}
+				opt.LeftSeries.Values[9] = GetNullValue()
+				return opt
+			},
+		},
+		{
+			name: "label_formatter_default_categories",
+			makeOptions: func() DivergingBarChartOption {
+				opt := makeBasicDivergingBarChartOption()
+				opt.Categories = nil
+				opt.LeftSeries.Values = opt.LeftSeries.Values[:5]
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					return name, &LabelStyle{FontStyle: FontStyle{FontColor: ColorRed}}
+				}
+				return opt
+			},
+		},
+		{
+			name: "no_labels_legend_hidden",
+			makeOptions: func() DivergingBarChartOption {
+				opt := makeBasicDivergingBarChartOption()
+				opt.Label.Show = Ptr(false)
+				opt.Legend.Show = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() DivergingBarChartOption {
+				opt := makeBasicDivergingBarChartOption()
+				opt.LeftSeries.Values = nil
+				opt.RightSeries.Values = nil
+				return opt
+			},
+		},
+		{
+			name: "values_beyond_max",
+			makeOptions: func() DivergingBarChartOption {
+				opt := makeBasicDivergingBarChartOption()
+				opt.Max = Ptr(2.5)
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.DivergingBarChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestDivergingBarChartError(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	})
+	opt := makeBasicDivergingBarChartOption()
+	opt.CenterLabels = Ptr(true)
+	opt.Categories[0] = "A category name much too long to leave any room for the diverging bars on either side"
+	err := p.DivergingBarChart(opt)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "insufficient space for diverging bar chart")
+}
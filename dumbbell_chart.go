@@ -0,0 +1,245 @@
+package charts
+
+import (
+	"errors"
+	"math"
+)
+
+// DumbbellSeries provides one end of the dumbbells, with a value for each category. Each category renders a
+// dumbbell joining the values of every series.
+type DumbbellSeries struct {
+	// Name specifies the series name, shown in the legend.
+	Name string
+	// Values provides the value for each category. Null values are excluded from the dumbbell.
+	Values []float64
+}
+
+// DumbbellChartOption defines the options for rendering a dumbbell chart, comparing two or more values per
+// category by joining them with a line with a symbol at each value. Render the chart using Painter.DumbbellChart.
+type DumbbellChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// SeriesList provides the values for the chart, the symbols of each series using the matching theme color.
+	SeriesList []DumbbellSeries
+	// Horizontal when true renders horizontal dumbbells, swapping the category and value axis.
+	Horizontal bool
+	// CategoryAxis configures the category axis.
+	CategoryAxis CategoryAxisOption
+	// ValueAxis configures the value (numeric) axis.
+	ValueAxis ValueAxisOption
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the data legend.
+	Legend LegendOption
+	// SymbolSize is the radius of the symbols at each value. Default is 5.
+	SymbolSize float64
+	// LineColor sets the color of the line joining the values. Default is a translucent theme label text color.
+	LineColor Color
+	// LineStrokeWidth is the stroke width of the line joining the values. Default is 3.
+	LineStrokeWidth float64
+	// Label configures the value labels, rendered beyond the ends of each dumbbell when Show is *true.
+	Label SeriesLabel
+	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
+	ValueFormatter ValueFormatter
+}
+
+type dumbbellChart struct {
+	p   *Painter
+	opt *DumbbellChartOption
+}
+
+// newDumbbellChart returns a dumbbell chart renderer.
+func newDumbbellChart(p *Painter, opt DumbbellChartOption) *dumbbellChart {
+	return &dumbbellChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewDumbbellChartOptionWithData returns an initialized DumbbellChartOption with a series for each set of values.
+// The first dimension of the values indicates the series, while the second provides the value for each category.
+func NewDumbbellChartOptionWithData(values [][]float64) DumbbellChartOption {
+	seriesList := make([]DumbbellSeries, len(values))
+	for i, v := range values {
+		seriesList[i] = DumbbellSeries{Values: v}
+	}
+	return DumbbellChartOption{
+		SeriesList:     seriesList,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+func (d *dumbbellChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := d.p
+	opt := d.opt
+	seriesPainter := result.seriesPainter
+	categoryRange := result.categoryAxisRange
+	valueRange := result.valueAxisRanges[0]
+	if categoryRange.divideCount == 0 {
+		return BoxZero, errors.New("dumbbell chart category axis produced no slots")
+	}
+
+	symbolSize := opt.SymbolSize
+	if symbolSize <= 0 {
+		symbolSize = 5
+	}
+	lineColor := opt.LineColor
+	if lineColor.IsZero() {
+		lineColor = opt.Theme.GetLabelTextColor().WithAlpha(96)
+	}
+	strokeWidth := opt.LineStrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 3
+	}
+	showLabels := flagIs(true, opt.Label.Show)
+	fontStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize, opt.Theme.GetLabelTextColor(),
+		seriesPainter.font)
+	valueFormatter := getPreferredValueFormatter(opt.Label.ValueFormatter, opt.ValueFormatter)
+	labelMargin := int(math.Ceil(symbolSize)) + 4
+
+	divideValues := categoryRange.autoDivide()
+	// point returns the pixel position of the value within the category slot
+	point := func(slot int, value float64) Point {
+		center := (divideValues[slot] + divideValues[slot+1]) >> 1
+		if opt.Horizontal {
+			return Point{X: valueRange.valuePosition(value), Y: center}
+		}
+		return Point{X: center, Y: valueRange.getRestHeight(value)}
+	}
+	for index := 0; index < categoryRange.divideCount; index++ {
+		slot := index
+		if opt.Horizontal { // reversed to align with the vertical category axis labels
+			slot = categoryRange.divideCount - index - 1
+		}
+		minIndex, maxIndex := -1, -1
+		for i, series := range opt.SeriesList {
+			if index >= len(series.Values) || !isValidExtent(series.Values[index]) {
+				continue
+			}
+			if minIndex == -1 || series.Values[index] < opt.SeriesList[minIndex].Values[index] {
+				minIndex = i
+			}
+			if maxIndex == -1 || series.Values[index] > opt.SeriesList[maxIndex].Values[index] {
+				maxIndex = i
+			}
+		}
+		if minIndex == -1 {
+			continue // no values for the category
+		}
+		minPoint := point(slot, opt.SeriesList[minIndex].Values[index])
+		maxPoint := point(slot, opt.SeriesList[maxIndex].Values[index])
+		if minIndex != maxIndex {
+			seriesPainter.LineStroke([]Point{minPoint, maxPoint}, lineColor, strokeWidth)
+		}
+		for i, series := range opt.SeriesList {
+			if index >= len(series.Values) || !isValidExtent(series.Values[index]) {
+				continue
+			}
+			pt := point(slot, series.Values[index])
+			color := opt.Theme.GetSeriesColor(i)
+			seriesPainter.Circle(symbolSize, pt.X, pt.Y, color, color, 0)
+		}
+		if !showLabels {
+			continue
+		}
+
+		// labels extend outward from the ends of the dumbbell, values in between are labeled at the side
+		for i, series := range opt.SeriesList {
+			if index >= len(series.Values) || !isValidExtent(series.Values[index]) {
+				continue
+			}
+			value := series.Values[index]
+			text := valueFormatter(value)
+			labelStyle := fontStyle
+			if opt.Label.LabelFormatter != nil {
+				var style *LabelStyle
+				text, style = opt.Label.LabelFormatter(index, series.Name, value)
+				if style != nil {
+					labelStyle = mergeFontStyles(style.FontStyle, fontStyle)
+				}
+			}
+			if text == "" {
+				continue
+			}
+			textBox := seriesPainter.MeasureText(text, 0, labelStyle)
+			pt := point(slot, value)
+			isMin := i == minIndex && minIndex != maxIndex
+			isMax := i == maxIndex || minIndex == maxIndex
+			var x, y int
+			if opt.Horizontal {
+				y = pt.Y + textBox.Height()/2
+				if isMin {
+					x = pt.X - labelMargin - textBox.Width()
+				} else if isMax {
+					x = pt.X + labelMargin
+				} else {
+					x, y = pt.X-textBox.Width()/2, pt.Y-labelMargin
+				}
+			} else {
+				x = pt.X - textBox.Width()/2
+				if isMin {
+					y = pt.Y + labelMargin + textBox.Height()
+				} else if isMax {
+					y = pt.Y - labelMargin
+				} else {
+					x, y = pt.X+labelMargin, pt.Y+textBox.Height()/2
+				}
+			}
+			// slide the label back within the plot when it extends beyond the edge
+			x = min(max(x, 0), seriesPainter.Width()-textBox.Width())
+			y = min(max(y, textBox.Height()), seriesPainter.Height())
+			seriesPainter.Text(text, x, y, 0, labelStyle)
+		}
+	}
+	return p.box, nil
+}
+
+func (d *dumbbellChart) Render() (Box, error) {
+	p := d.p
+	opt := d.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolDot
+	}
+
+	values := make([][]float64, len(opt.SeriesList))
+	var categoryCount int
+	for i, series := range opt.SeriesList {
+		values[i] = series.Values
+		categoryCount = max(categoryCount, len(series.Values))
+	}
+	// the series values are provided as scatter series to drive the axis range and legend
+	seriesList := NewSeriesListScatter(values)
+	for i, series := range opt.SeriesList {
+		seriesList[i].Name = series.Name
+	}
+	categoryAxis := opt.CategoryAxis
+	valueAxis := []ValueAxisOption{opt.ValueAxis}
+	normalizeBarAxisPositions(opt.Horizontal, &categoryAxis, valueAxis)
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:          opt.Theme,
+		padding:        opt.Padding,
+		seriesList:     seriesList,
+		categoryAxis:   &categoryAxis,
+		valueAxis:      valueAxis,
+		title:          opt.Title,
+		legend:         &opt.Legend,
+		valueFormatter: opt.ValueFormatter,
+		categoryY:      opt.Horizontal,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	if len(opt.SeriesList) == 0 || categoryCount == 0 {
+		renderResult.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	return d.renderChart(renderResult)
+}
@@ -0,0 +1,112 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicDumbbellChartOption() DumbbellChartOption {
+	opt := NewDumbbellChartOptionWithData([][]float64{
+		{120, 95, 140, 72, 88},
+		{150, 90, 180, 85, 102},
+	})
+	opt.SeriesList[0].Name = "Before"
+	opt.SeriesList[1].Name = "After"
+	opt.CategoryAxis.Labels = []string{"Login", "Search", "Checkout", "Profile", "Export"}
+	return opt
+}
+
+func TestNewDumbbellChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewDumbbellChartOptionWithData([][]float64{{1, 2}, {3, 4}})
+
+	require.Len(t, opt.SeriesList, 2)
+	assert.Equal(t, []float64{3, 4}, opt.SeriesList[1].Values)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.Theme)
+	assert.NotNil(t, opt.ValueFormatter)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.DumbbellChart(opt))
+}
+
+func TestDumbbellChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() DumbbellChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBasicDumbbellChartOption,
+		},
+		{
+			name: "horizontal_labels",
+			makeOptions: func() DumbbellChartOption {
+				opt := makeBasicDumbbellChartOption()
+				opt.Horizontal = true
+				opt.Title.Text = "Latency"
+				opt.Label.Show = Ptr(true)
+				opt.ValueAxis.Min = Ptr(50.0)
+				opt.ValueAxis.Max = Ptr(200.0)
+				return opt
+			},
+		},
+		{
+			name: "three_series_dark",
+			makeOptions: func() DumbbellChartOption {
+				opt := makeBasicDumbbellChartOption()
+				opt.Theme = GetTheme(ThemeDark)
+				opt.SeriesList = append(opt.SeriesList, DumbbellSeries{
+					Name:   "Target",
+					Values: []float64{135, 110, 160, 60, GetNullValue()},
+				})
+				opt.Label.Show = Ptr(true)
+				opt.ValueAxis.Min = Ptr(40.0)
+				opt.Label.ValueFormatter = func(f float64) string {
+					return FormatValueHumanize(f, 0, false) + "ms"
+				}
+				return opt
+			},
+		},
+		{
+			name: "custom_line_symbol",
+			makeOptions: func() DumbbellChartOption {
+				opt := makeBasicDumbbellChartOption()
+				opt.LineColor = ColorBlack
+				opt.LineStrokeWidth = 1
+				opt.SymbolSize = 8
+				opt.Legend.Show = Ptr(false)
+				opt.SeriesList[1].Values[1] = GetNullValue()
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() DumbbellChartOption {
+				opt := makeBasicDumbbellChartOption()
+				opt.SeriesList = nil
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.DumbbellChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
@@ -327,12 +327,14 @@ func (eml *EChartsMarkLine) ToSeriesMarkLine() SeriesMarkLine {
 
 // EChartsSeries holds data and styling for one chart series.
 type EChartsSeries struct {
-	Data       []EChartsSeriesData `json:"data"`
-	Name       string              `json:"name"`
-	Type       string              `json:"type"`
-	Radius     string              `json:"radius"`
-	YAxisIndex int                 `json:"yAxisIndex"`
-	ItemStyle  EChartStyle         `json:"itemStyle,omitempty"` // TODO - add support
+	Data        []EChartsSeriesData `json:"data"`
+	Name        string              `json:"name"`
+	Type        string              `json:"type"`
+	Radius      string              `json:"radius"`
+	InnerRadius string              `json:"-"` // set from the radius when provided as an [inner, outer] pair
+	RoseType    string              `json:"roseType"`
+	YAxisIndex  int                 `json:"yAxisIndex"`
+	ItemStyle   EChartStyle         `json:"itemStyle,omitempty"` // TODO - add support
 	// label configuration
 	Label     EChartsLabelOption `json:"label"`
 	MarkPoint EChartsMarkPoint   `json:"markPoint"`
@@ -341,6 +343,43 @@ type EChartsSeries struct {
 	Min       *float64           `json:"min"` // TODO - add support
 }
 
+type _EChartsSeries EChartsSeries
+
+// UnmarshalJSON decodes a series, accepting the radius as a single value or an [inner, outer] pair, and the
+// roseType as a string or boolean.
+func (es *EChartsSeries) UnmarshalJSON(data []byte) error {
+	v := struct {
+		*_EChartsSeries
+		Radius   json.RawMessage `json:"radius"`
+		RoseType json.RawMessage `json:"roseType"`
+	}{_EChartsSeries: (*_EChartsSeries)(es)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	es.RoseType = ""
+	if roseType := string(bytes.TrimSpace(v.RoseType)); roseType == "true" {
+		es.RoseType = PieRoseTypeRadius // boolean true is the radius rose type
+	} else if strings.HasPrefix(roseType, `"`) {
+		if err := json.Unmarshal(v.RoseType, &es.RoseType); err != nil {
+			return err
+		}
+	}
+	es.Radius, es.InnerRadius = "", ""
+	if radiusData := convertToArray(v.Radius); len(radiusData) != 0 && string(radiusData) != "[null]" {
+		var radius []EChartsPosition
+		if err := json.Unmarshal(radiusData, &radius); err != nil {
+			return err
+		}
+		if len(radius) == 1 {
+			es.Radius = string(radius[0])
+		} else if len(radius) > 1 {
+			es.InnerRadius = string(radius[0])
+			es.Radius = string(radius[1])
+		}
+	}
+	return nil
+}
+
 // EChartsSeriesList is a list of EChartsSeries values.
 type EChartsSeriesList []EChartsSeries
 
@@ -543,6 +582,16 @@ func (eo *EChartsOption) ToOption() ChartOption {
 		Box:             eo.Box.ToBox(),
 		SeriesList:      eo.Series.ToSeriesList(),
 	}
+	for _, item := range eo.Series {
+		if item.Type == ChartTypePie {
+			if item.RoseType != "" {
+				o.RoseType = item.RoseType
+			}
+			if item.InnerRadius != "" {
+				o.InnerRadius = item.InnerRadius
+			}
+		}
+	}
 	if fallbackFont != nil {
 		for i := range o.SeriesList {
 			if o.SeriesList[i].Label.FontStyle.Font == nil {
@@ -106,6 +106,42 @@ func TestEChartsSeriesData(t *testing.T) {
 	}, es)
 }
 
+func TestEChartsSeries(t *testing.T) {
+	t.Parallel()
+
+	var es EChartsSeries
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"pie","radius":"50%","data":[1]}`), &es))
+	assert.Equal(t, "50%", es.Radius)
+	assert.Empty(t, es.InnerRadius)
+	assert.Empty(t, es.RoseType)
+	assert.Len(t, es.Data, 1)
+
+	es = EChartsSeries{}
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"pie","radius":[20,"60%"],"roseType":"area"}`), &es))
+	assert.Equal(t, "60%", es.Radius)
+	assert.Equal(t, "20", es.InnerRadius)
+	assert.Equal(t, PieRoseTypeArea, es.RoseType)
+
+	es = EChartsSeries{}
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"pie","radius":140,"roseType":true}`), &es))
+	assert.Equal(t, "140", es.Radius)
+	assert.Equal(t, PieRoseTypeRadius, es.RoseType)
+
+	es = EChartsSeries{}
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"pie","radius":null,"roseType":false}`), &es))
+	assert.Empty(t, es.Radius)
+	assert.Empty(t, es.RoseType)
+
+	require.Error(t, json.Unmarshal([]byte(`{"radius":{}}`), &es))
+
+	eo := EChartsOption{
+		Series: EChartsSeriesList{{Type: ChartTypePie, InnerRadius: "10%", RoseType: PieRoseTypeArea}},
+	}
+	opt := eo.ToOption()
+	assert.Equal(t, PieRoseTypeArea, opt.RoseType)
+	assert.Equal(t, "10%", opt.InnerRadius)
+}
+
 func TestEChartsXAxis(t *testing.T) {
 	t.Parallel()
 
@@ -707,6 +743,24 @@ func TestRenderEChartsToSVG(t *testing.T) {
 				"series": [{ "data": [40, 70], "type": "line" }]
 			}`,
 		},
+		{
+			name: "pie_rose_type",
+			jsonData: `{
+				"title": { "text": "Nightingale" },
+				"series": [{
+					"type": "pie",
+					"roseType": "area",
+					"radius": [20, "40%"],
+					"data": [
+						{ "value": 40, "name": "rose 1" },
+						{ "value": 32, "name": "rose 2" },
+						{ "value": 28, "name": "rose 3" },
+						{ "value": 22, "name": "rose 4" },
+						{ "value": 18, "name": "rose 5" }
+					]
+				}]
+			}`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,169 @@
+package charts
+
+// SeriesErrorBar configures error bars for a series, drawn as whiskers with caps spanning the error range of each
+// value. Errors are provided as symmetric or asymmetric distances from the value, or derived from the standard
+// deviation of the series values.
+type SeriesErrorBar struct {
+	// Errors provides a symmetric error for each value, drawn from value-error to value+error.
+	Errors []float64
+	// Lower provides the error below each value for asymmetric error bars, overriding Errors when set.
+	Lower []float64
+	// Upper provides the error above each value for asymmetric error bars, overriding Errors when set.
+	Upper []float64
+	// StdDevMultiplier when greater than zero derives a symmetric error for every value from the standard deviation
+	// of the series values, multiplied by this factor. Ignored when Errors, Lower, or Upper are set.
+	StdDevMultiplier float64
+	// Color sets the color of the error bars. Default is a darker shade of the series color.
+	Color Color
+	// StrokeWidth is the width of the whisker and cap lines. Default is 1.
+	StrokeWidth float64
+	// CapWidth is the pixel width of the caps. Default is half the bar width for bars, or 8 for lines and points.
+	CapWidth int
+}
+
+// isSet returns true if the error bar has errors to render.
+func (e SeriesErrorBar) isSet() bool {
+	return len(e.Errors) != 0 || len(e.Lower) != 0 || len(e.Upper) != 0 || e.StdDevMultiplier > 0
+}
+
+// errorsAt returns the distances below and above the value at the index, or false if no valid error is set.
+func (e SeriesErrorBar) errorsAt(index int, stdDevError float64) (float64, float64, bool) {
+	errorAt := func(errs []float64) float64 {
+		if index < len(errs) && isValidExtent(errs[index]) {
+			return errs[index]
+		}
+		return GetNullValue()
+	}
+	lower, upper := GetNullValue(), GetNullValue()
+	if len(e.Lower) != 0 || len(e.Upper) != 0 {
+		lower, upper = errorAt(e.Lower), errorAt(e.Upper)
+	} else if len(e.Errors) != 0 {
+		lower = errorAt(e.Errors)
+		upper = lower
+	} else if e.StdDevMultiplier > 0 {
+		lower, upper = stdDevError, stdDevError
+	}
+	if !isValidExtent(lower) && !isValidExtent(upper) {
+		return 0, 0, false
+	}
+	// a missing side on asymmetric bars is drawn without extension
+	if !isValidExtent(lower) {
+		lower = 0
+	} else if !isValidExtent(upper) {
+		upper = 0
+	}
+	return lower, upper, true
+}
+
+// stdDevError returns the derived symmetric error when StdDevMultiplier is set.
+func (e SeriesErrorBar) stdDevError(values []float64) float64 {
+	if e.StdDevMultiplier <= 0 {
+		return 0
+	}
+	return summarizePopulationData(values).StandardDeviation * e.StdDevMultiplier
+}
+
+// bounds returns the low and high value for the error range at the index, or false if no error is set or the value
+// is null.
+func (e SeriesErrorBar) bounds(index int, value, stdDevError float64) (float64, float64, bool) {
+	if !isValidExtent(value) {
+		return 0, 0, false
+	}
+	lower, upper, ok := e.errorsAt(index, stdDevError)
+	if !ok {
+		return 0, 0, false
+	}
+	return value - lower, value + upper, true
+}
+
+// errorBarExtents returns the low and high values of the series error ranges so they may be included in the axis
+// range. When stacked the error ranges are offset by the accumulated values of the prior series.
+func errorBarExtents(errorBars []SeriesErrorBar, seriesValues [][]float64, stacked bool) []float64 {
+	var result []float64
+	var sums []float64
+	for i, e := range errorBars {
+		values := seriesValues[i]
+		if stacked && len(sums) < len(values) {
+			sums = append(sums, make([]float64, len(values)-len(sums))...)
+		}
+		stdDevError := e.stdDevError(values)
+		for j, v := range values {
+			var offset float64
+			if stacked && isValidExtent(v) {
+				offset = sums[j]
+				sums[j] += v
+			}
+			if low, high, ok := e.bounds(j, v, stdDevError); ok {
+				result = append(result, low+offset, high+offset)
+			}
+		}
+	}
+	return result
+}
+
+// errorBarPainter is responsible for rendering error bars on the chart.
+type errorBarPainter struct {
+	p       *Painter
+	options []errorBarRenderOption
+}
+
+// newErrorBarPainter returns a new error bar renderer.
+func newErrorBarPainter(p *Painter) *errorBarPainter {
+	return &errorBarPainter{
+		p: p,
+	}
+}
+
+// add appends an error bar render option.
+func (e *errorBarPainter) add(opt errorBarRenderOption) {
+	e.options = append(e.options, opt)
+}
+
+// errorBarRenderOption holds the pixel positions of a single error bar.
+type errorBarRenderOption struct {
+	// center is the position of the bar along the category (or x) axis.
+	center int
+	// low and high are the positions of the error range ends along the value axis.
+	low, high int
+	// horizontal indicates the value axis is horizontal, drawing the whisker horizontally.
+	horizontal  bool
+	capWidth    int
+	color       Color
+	strokeWidth float64
+}
+
+// Render draws all configured error bars.
+func (e *errorBarPainter) Render() (Box, error) {
+	for _, opt := range e.options {
+		capStart, capEnd := opt.center-opt.capWidth/2, opt.center+opt.capWidth/2
+		if opt.horizontal {
+			e.p.LineStroke([]Point{{X: opt.low, Y: opt.center}, {X: opt.high, Y: opt.center}},
+				opt.color, opt.strokeWidth)
+			e.p.LineStroke([]Point{{X: opt.low, Y: capStart}, {X: opt.low, Y: capEnd}}, opt.color, opt.strokeWidth)
+			e.p.LineStroke([]Point{{X: opt.high, Y: capStart}, {X: opt.high, Y: capEnd}}, opt.color, opt.strokeWidth)
+		} else {
+			e.p.LineStroke([]Point{{X: opt.center, Y: opt.low}, {X: opt.center, Y: opt.high}},
+				opt.color, opt.strokeWidth)
+			e.p.LineStroke([]Point{{X: capStart, Y: opt.low}, {X: capEnd, Y: opt.low}}, opt.color, opt.strokeWidth)
+			e.p.LineStroke([]Point{{X: capStart, Y: opt.high}, {X: capEnd, Y: opt.high}}, opt.color, opt.strokeWidth)
+		}
+	}
+	return e.p.box, nil
+}
+
+// errorBarStyle returns the color, stroke width, and cap width for the series error bars.
+func errorBarStyle(e SeriesErrorBar, seriesColor Color, defaultCapWidth int) (Color, float64, int) {
+	color := e.Color
+	if color.IsZero() {
+		color = seriesColor.WithAdjustHSL(0, 0.1, -0.2)
+	}
+	strokeWidth := e.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1
+	}
+	capWidth := e.CapWidth
+	if capWidth <= 0 {
+		capWidth = defaultCapWidth
+	}
+	return color, strokeWidth, capWidth
+}
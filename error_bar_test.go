@@ -0,0 +1,233 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeriesErrorBarBounds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		errorBar  SeriesErrorBar
+		values    []float64
+		expectSet bool
+		expected  [][2]float64 // low and high per value, NaN for no error bar
+	}{
+		{
+			name:     "unset",
+			errorBar: SeriesErrorBar{},
+			values:   []float64{1, 2},
+			expected: [][2]float64{{GetNullValue(), 0}, {GetNullValue(), 0}},
+		},
+		{
+			name:      "symmetric",
+			errorBar:  SeriesErrorBar{Errors: []float64{1, GetNullValue()}},
+			values:    []float64{5, 6, 7},
+			expectSet: true,
+			expected:  [][2]float64{{4, 6}, {GetNullValue(), 0}, {GetNullValue(), 0}},
+		},
+		{
+			name:      "asymmetric",
+			errorBar:  SeriesErrorBar{Lower: []float64{1, 2}, Upper: []float64{3}, Errors: []float64{10, 10}},
+			values:    []float64{5, 6},
+			expectSet: true,
+			expected:  [][2]float64{{4, 8}, {4, 6}},
+		},
+		{
+			name:      "std_dev",
+			errorBar:  SeriesErrorBar{StdDevMultiplier: 2},
+			values:    []float64{2, 4, 4, 4, 5, 5, 7, 9}, // population standard deviation of 2
+			expectSet: true,
+			expected: [][2]float64{
+				{-2, 6}, {0, 8}, {0, 8}, {0, 8}, {1, 9}, {1, 9}, {3, 11}, {5, 13},
+			},
+		},
+		{
+			name:      "null_value",
+			errorBar:  SeriesErrorBar{Errors: []float64{1}},
+			values:    []float64{GetNullValue()},
+			expectSet: true,
+			expected:  [][2]float64{{GetNullValue(), 0}},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectSet, tt.errorBar.isSet())
+			stdDevError := tt.errorBar.stdDevError(tt.values)
+			for j, v := range tt.values {
+				low, high, ok := tt.errorBar.bounds(j, v, stdDevError)
+				if !isValidExtent(tt.expected[j][0]) {
+					assert.False(t, ok, "index %d", j)
+					continue
+				}
+				require.True(t, ok, "index %d", j)
+				assert.InDelta(t, tt.expected[j][0], low, 0.000001, "index %d", j)
+				assert.InDelta(t, tt.expected[j][1], high, 0.000001, "index %d", j)
+			}
+		})
+	}
+}
+
+func TestErrorBarExtents(t *testing.T) {
+	t.Parallel()
+
+	lineSeries := NewSeriesListLine([][]float64{{10, 20}, {5, 5}})
+	lineSeries[0].ErrorBar = SeriesErrorBar{Errors: []float64{15, 2}}
+	lineSeries[1].YAxisIndex = 1
+	lineSeries[1].ErrorBar = SeriesErrorBar{Upper: []float64{100}}
+
+	minVal, maxVal, _ := getSeriesMinMaxSumMax(lineSeries, 0, false)
+	assert.InDelta(t, -5.0, minVal, 0)
+	assert.InDelta(t, 25.0, maxVal, 0)
+	minVal, maxVal, _ = getSeriesMinMaxSumMax(lineSeries, 1, false)
+	assert.InDelta(t, 5.0, minVal, 0)
+	assert.InDelta(t, 105.0, maxVal, 0)
+
+	barSeries := NewSeriesListBar([][]float64{{10, 20}})
+	barSeries[0].ErrorBar = SeriesErrorBar{Lower: []float64{12}}
+	minVal, maxVal, _ = getSeriesMinMaxSumMax(barSeries, 0, false)
+	assert.InDelta(t, -2.0, minVal, 0)
+	assert.InDelta(t, 20.0, maxVal, 0)
+
+	stackedSeries := NewSeriesListBar([][]float64{{10, 20}, {5, GetNullValue()}})
+	stackedSeries[1].ErrorBar = SeriesErrorBar{Errors: []float64{3, 3}}
+	_, _, sumMax := getSeriesMinMaxSumMax(stackedSeries, 0, true)
+	assert.InDelta(t, 20.0, sumMax, 0)
+	stackedSeries[0].Values[0] = 15
+	_, _, sumMax = getSeriesMinMaxSumMax(stackedSeries, 0, true)
+	assert.InDelta(t, 23.0, sumMax, 0) // error offset by the stacked value below
+
+	scatterSeries := NewSeriesListScatterMultiValue([][][]float64{{{1, 3}, {2}}})
+	scatterSeries[0].ErrorBar = SeriesErrorBar{Errors: []float64{1, 4}}
+	minVal, maxVal, _ = getSeriesMinMaxSumMax(scatterSeries, 0, false)
+	assert.InDelta(t, -2.0, minVal, 0)
+	assert.InDelta(t, 6.0, maxVal, 0)
+
+	bandSeries := lineBandSeriesList{
+		seriesList: lineSeries,
+		bands:      []BandSeries{{Lower: []float64{-10}, Upper: []float64{0}}},
+	}
+	minVal, maxVal, _ = getSeriesMinMaxSumMax(bandSeries, 0, false)
+	assert.InDelta(t, -10.0, minVal, 0)
+	assert.InDelta(t, 25.0, maxVal, 0)
+}
+
+func TestErrorBarGeneric(t *testing.T) {
+	t.Parallel()
+
+	symmetric := SeriesErrorBar{Errors: []float64{2, 3, 1.5, 4, 2.5}}
+	barSeries := NewSeriesListBar([][]float64{{12, 18, 9, 22, 15}})
+	barSeries[0].ErrorBar = symmetric
+	lineSeries := NewSeriesListLine([][]float64{{8, 14, 11, 17, 10}})
+	lineSeries[0].ErrorBar = SeriesErrorBar{Upper: []float64{6, 6, 6, 6, 6}}
+	scatterSeries := NewSeriesListScatter([][]float64{{5, 7, 4, 6, 5}})
+	scatterSeries[0].ErrorBar = SeriesErrorBar{StdDevMultiplier: 1}
+
+	seriesList := append(append(barSeries.ToGenericSeriesList(), lineSeries.ToGenericSeriesList()...),
+		scatterSeries.ToGenericSeriesList()...)
+	assert.Equal(t, symmetric, filterSeriesList[BarSeriesList](seriesList, ChartTypeBar)[0].ErrorBar)
+	assert.Equal(t, lineSeries[0].ErrorBar, filterSeriesList[LineSeriesList](seriesList, ChartTypeLine)[0].ErrorBar)
+	assert.Equal(t, scatterSeries[0].ErrorBar,
+		filterSeriesList[ScatterSeriesList](seriesList, ChartTypeScatter)[0].ErrorBar)
+	_, maxVal, _ := getSeriesMinMaxSumMax(seriesList, 0, false)
+	assert.InDelta(t, 26.0, maxVal, 0)
+
+	p, err := Render(ChartOption{
+		OutputFormat: ChartOutputSVG,
+		SeriesList:   seriesList,
+	})
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+}
+
+func TestErrorBarChart(t *testing.T) {
+	t.Parallel()
+
+	labels := []string{"A", "B", "C", "D", "E"}
+	values := [][]float64{
+		{12, 18, 9, 22, 15},
+		{8, 14, 11, 17, 10},
+	}
+	symmetric := SeriesErrorBar{Errors: []float64{2, 3, 1.5, 4, 2.5}}
+	asymmetric := SeriesErrorBar{Lower: []float64{1, 2, 1, 3, 2}, Upper: []float64{3, 1, 4, 2, 5}}
+
+	tests := []struct {
+		name   string
+		render func(*Painter) error
+	}{
+		{
+			name: "bar",
+			render: func(p *Painter) error {
+				opt := NewBarChartOptionWithData(values)
+				opt.CategoryAxis.Labels = labels
+				opt.SeriesList[0].ErrorBar = symmetric
+				opt.SeriesList[1].ErrorBar = asymmetric
+				return p.BarChart(opt)
+			},
+		},
+		{
+			name: "bar_stacked_std_dev",
+			render: func(p *Painter) error {
+				opt := NewBarChartOptionWithData(values)
+				opt.CategoryAxis.Labels = labels
+				opt.StackSeries = Ptr(true)
+				opt.SeriesList[1].ErrorBar = SeriesErrorBar{StdDevMultiplier: 1, Color: ColorBlack, StrokeWidth: 2}
+				return p.BarChart(opt)
+			},
+		},
+		{
+			name: "horizontal_bar",
+			render: func(p *Painter) error {
+				opt := NewBarChartOptionWithData(values)
+				opt.Horizontal = true
+				opt.CategoryAxis.Labels = labels
+				opt.SeriesList[0].ErrorBar = symmetric
+				opt.SeriesList[1].ErrorBar = asymmetric
+				opt.SeriesList[1].ErrorBar.CapWidth = 4
+				return p.BarChart(opt)
+			},
+		},
+		{
+			name: "line",
+			render: func(p *Painter) error {
+				opt := NewLineChartOptionWithData(values)
+				opt.XAxis.Labels = labels
+				opt.SeriesList[0].ErrorBar = symmetric
+				opt.SeriesList[1].ErrorBar = SeriesErrorBar{Upper: []float64{6, 6, 6, 6, 6}}
+				return p.LineChart(opt)
+			},
+		},
+		{
+			name: "scatter",
+			render: func(p *Painter) error {
+				opt := NewScatterChartOptionWithData(values)
+				opt.XAxis.Labels = labels
+				opt.SeriesList[0].ErrorBar = symmetric
+				opt.SeriesList[1].ErrorBar = SeriesErrorBar{StdDevMultiplier: 0.5, CapWidth: 12}
+				return p.ScatterChart(opt)
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, tt.render(p))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
@@ -0,0 +1,507 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+const defaultGanttBarSize = 0.6
+
+const (
+	// ganttAxisTickLength is the length of the time axis tick marks.
+	ganttAxisTickLength = 5
+	// ganttAxisLabelMargin is the space between the time axis tick marks and labels.
+	ganttAxisLabelMargin = 4
+)
+
+// ganttTimeSteps are the candidate intervals between time axis labels.
+var ganttTimeSteps = []time.Duration{
+	time.Hour, 2 * time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour,
+	24 * time.Hour, 2 * 24 * time.Hour, 7 * 24 * time.Hour, 14 * 24 * time.Hour, 28 * 24 * time.Hour,
+	91 * 24 * time.Hour, 182 * 24 * time.Hour, 364 * 24 * time.Hour,
+}
+
+// GanttTask is a row of a gantt chart.
+type GanttTask struct {
+	// Name is the task name, rendered as the row label and referenced by Dependencies.
+	Name string
+	// Group optionally assigns the task to a group, tasks in the same group are rendered together under a group
+	// header row.
+	Group string
+	// Start is when the task begins.
+	Start time.Time
+	// End is when the task finishes. A zero End, or one not after Start, renders the task as a milestone.
+	End time.Time
+	// Dependencies lists the names of tasks which must finish before this task starts, rendered as arrows. Each
+	// name must match a single task.
+	Dependencies []string
+	// Color overrides the task bar color. Defaults to the theme series color of the task group.
+	Color Color
+}
+
+// isMilestone returns true if the task has no duration.
+func (t GanttTask) isMilestone() bool {
+	return !t.End.After(t.Start)
+}
+
+// GanttChartOption defines the options for rendering a gantt chart. Render the chart using Painter.GanttChart.
+type GanttChartOption struct {
+	// Theme specifies the colors used for the chart. Each task group is assigned a series color.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the legend of task groups. The legend is only shown when Show is *true.
+	Legend LegendOption
+	// Tasks provides the chart rows, rendered top to bottom with tasks of the same group kept together.
+	Tasks []GanttTask
+	// Start overrides the start of the time axis, the axis begins exactly at Start when set. Defaults to the
+	// earliest task start, widened to the preceding tick. Tasks are clipped to the axis range, and tasks entirely
+	// outside it are not rendered.
+	Start time.Time
+	// End overrides the end of the time axis, the axis ends exactly at End when set. Defaults to the latest task
+	// end, widened to the following tick.
+	End time.Time
+	// TimeFormat specifies the Go time layout for the time axis labels. Defaults to a layout based on the
+	// label interval.
+	TimeFormat string
+	// Today when set renders a dashed marker line at the time.
+	Today time.Time
+	// TodayColor overrides the color of the today marker line. Default is red.
+	TodayColor Color
+	// BarSize sets each bar's height as a ratio of the row height (0.0–1.0). Default is 0.6.
+	BarSize float64
+	// ShowGroupHeader when set to *false hides the group header rows which span the time of the group tasks.
+	ShowGroupHeader *bool
+	// XAxisFontStyle specifies the font for the time axis labels.
+	XAxisFontStyle FontStyle
+	// YAxisFontStyle specifies the font for the row labels.
+	YAxisFontStyle FontStyle
+}
+
+type ganttChart struct {
+	p   *Painter
+	opt *GanttChartOption
+}
+
+// newGanttChart returns a gantt chart renderer.
+func newGanttChart(p *Painter, opt GanttChartOption) *ganttChart {
+	return &ganttChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewGanttChartOptionWithData returns an initialized GanttChartOption with the provided tasks.
+func NewGanttChartOptionWithData(tasks []GanttTask) GanttChartOption {
+	return GanttChartOption{
+		Tasks:   tasks,
+		Padding: defaultPadding,
+		Theme:   GetDefaultTheme(),
+	}
+}
+
+// ganttRow is a rendered row, either a task or a group header.
+type ganttRow struct {
+	label      string
+	groupIndex int
+	task       *GanttTask // nil for a group header
+	start, end time.Time
+}
+
+// ganttRows orders the tasks by group in order of first appearance, adding a header row before each named group
+// when enabled. The group names are also returned.
+func ganttRows(tasks []GanttTask, showGroupHeader bool) ([]ganttRow, []string) {
+	var groups []string
+	for _, task := range tasks {
+		if !slices.Contains(groups, task.Group) {
+			groups = append(groups, task.Group)
+		}
+	}
+	var rows []ganttRow
+	var names []string
+	for _, group := range groups {
+		groupIndex := len(names)
+		if group != "" {
+			names = append(names, group)
+		} else {
+			groupIndex = 0 // ungrouped tasks share the first series color
+		}
+		if group != "" && showGroupHeader {
+			header := ganttRow{label: group, groupIndex: groupIndex}
+			for _, task := range tasks {
+				if task.Group != group {
+					continue
+				}
+				end := task.End
+				if task.isMilestone() {
+					end = task.Start
+				}
+				if header.start.IsZero() || task.Start.Before(header.start) {
+					header.start = task.Start
+				}
+				if end.After(header.end) {
+					header.end = end
+				}
+			}
+			rows = append(rows, header)
+		}
+		for i := range tasks {
+			if tasks[i].Group == group {
+				rows = append(rows, ganttRow{
+					label:      tasks[i].Name,
+					groupIndex: groupIndex,
+					task:       &tasks[i],
+					start:      tasks[i].Start,
+					end:        tasks[i].End,
+				})
+			}
+		}
+	}
+	return rows, names
+}
+
+// ganttTimeRange returns the extent of the tasks, expanded to the optional start and end overrides.
+func ganttTimeRange(tasks []GanttTask, start, end time.Time) (time.Time, time.Time) {
+	var minTime, maxTime time.Time
+	for _, task := range tasks {
+		taskEnd := task.End
+		if task.isMilestone() {
+			taskEnd = task.Start
+		}
+		if minTime.IsZero() || task.Start.Before(minTime) {
+			minTime = task.Start
+		}
+		if maxTime.IsZero() || taskEnd.After(maxTime) {
+			maxTime = taskEnd
+		}
+	}
+	if !start.IsZero() {
+		minTime = start
+	}
+	if !end.IsZero() {
+		maxTime = end
+	}
+	return minTime, maxTime
+}
+
+// ganttTimeTicks returns evenly spaced tick times covering the range, using the smallest interval which results
+// in about maxTicks intervals. Day or longer intervals start at midnight, and week or longer intervals start on
+// a Monday.
+func ganttTimeTicks(start, end time.Time, maxTicks int) ([]time.Time, time.Duration) {
+	maxTicks = max(maxTicks, 1)
+	step := ganttTimeSteps[len(ganttTimeSteps)-1]
+	for _, s := range ganttTimeSteps {
+		if int(float64(end.Sub(start))/float64(s))+1 <= maxTicks {
+			step = s
+			break
+		}
+	}
+
+	// built from the date so the hour is truncated in the start location, rather than relative to UTC
+	first := time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), 0, 0, 0, start.Location())
+	if step >= 24*time.Hour {
+		first = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+		if step >= 7*24*time.Hour {
+			weekday := (int(first.Weekday()) + 6) % 7 // days since Monday
+			first = first.AddDate(0, 0, -weekday)
+		}
+	} else {
+		first = first.Add(-time.Duration(first.Hour()%int(step/time.Hour)) * time.Hour)
+	}
+	// the last tick is after the end so a milestone at the end is not clipped
+	ticks := []time.Time{first}
+	for last := first; !last.After(end); ticks = append(ticks, last) {
+		if step%(24*time.Hour) == 0 {
+			last = last.AddDate(0, 0, int(step/(24*time.Hour))) // calendar days remain at midnight across DST
+		} else {
+			last = last.Add(step)
+		}
+	}
+	return ticks, step
+}
+
+// ganttTimeFormat returns the default time layout for labels at the given interval.
+func ganttTimeFormat(step time.Duration, start, end time.Time) string {
+	if step < 24*time.Hour {
+		return "Jan 2 15:04"
+	} else if start.Year() != end.Year() {
+		return "Jan 2 2006"
+	}
+	return "Jan 2"
+}
+
+// ganttTimeAxis is the range of the time axis, with the ticks and the labels centered within each tick interval.
+type ganttTimeAxis struct {
+	start, end time.Time
+	ticks      []time.Time
+	labels     []string
+	fontStyle  FontStyle
+}
+
+func (g *ganttChart) renderChart(result *defaultRenderResult, rows []ganttRow, timeAxis ganttTimeAxis) (Box, error) {
+	opt := g.opt
+	seriesPainter := result.seriesPainter
+	width := seriesPainter.Width()
+	axisStart, axisEnd := timeAxis.start, timeAxis.end
+	// positions are clamped to the plot, as a Start or End override can narrow the range within the tasks
+	xForTime := func(t time.Time) int {
+		x := math.Round(float64(width) * float64(t.Sub(axisStart)) / float64(axisEnd.Sub(axisStart)))
+		return int(min(max(x, 0), float64(width)))
+	}
+	outsideRange := func(start, end time.Time) bool {
+		if end.Equal(start) { // milestone
+			return start.Before(axisStart) || start.After(axisEnd)
+		}
+		return !end.After(axisStart) || !start.Before(axisEnd)
+	}
+	yValues := autoDivide(seriesPainter.Height(), len(rows))
+	barSize := opt.BarSize
+	if barSize <= 0 || barSize > 1 {
+		barSize = defaultGanttBarSize
+	}
+
+	// locate the bounds of each task for drawing dependencies
+	type taskBounds struct {
+		startX, endX, centerY int
+		rowIndex              int
+	}
+	bounds := make([]*taskBounds, len(rows)) // nil for group headers and tasks outside the range
+	taskRows := make(map[string]int, len(rows))
+	for i, row := range rows {
+		rowHeight := yValues[i+1] - yValues[i]
+		centerY := (yValues[i] + yValues[i+1]) / 2
+		color := opt.Theme.GetSeriesColor(row.groupIndex)
+		if row.task != nil {
+			taskRows[row.task.Name] = i
+			if row.task.isMilestone() {
+				row.end = row.start
+			}
+		}
+		if outsideRange(row.start, row.end) {
+			continue
+		} else if row.task == nil {
+			// group header spans the group tasks as a thin bar
+			barHeight := max(int(float64(rowHeight)*barSize/3), 2)
+			headerColor := color.WithAdjustHSL(0, 0, -0.15)
+			if opt.Theme.IsDark() {
+				headerColor = color.WithAdjustHSL(0, 0, 0.15)
+			}
+			seriesPainter.FilledRect(xForTime(row.start), centerY-barHeight/2, xForTime(row.end),
+				centerY-barHeight/2+barHeight, headerColor, headerColor, 0)
+			continue
+		}
+		if !row.task.Color.IsZero() {
+			color = row.task.Color
+		}
+		barHeight := max(int(float64(rowHeight)*barSize), 2)
+		startX := xForTime(row.start)
+		if row.task.isMilestone() {
+			seriesPainter.FilledDiamond(startX, centerY, barHeight, barHeight, color, color, 0)
+			bounds[i] = &taskBounds{startX: startX - barHeight/2, endX: startX + barHeight/2,
+				centerY: centerY, rowIndex: i}
+			continue
+		}
+		endX := max(xForTime(row.end), startX+1)
+		seriesPainter.FilledRect(startX, centerY-barHeight/2, endX, centerY-barHeight/2+barHeight,
+			color, color, 0)
+		bounds[i] = &taskBounds{startX: startX, endX: endX, centerY: centerY, rowIndex: i}
+	}
+
+	// dependency arrows route from the end of the prior task to the start of the dependent task
+	arrowColor := opt.Theme.GetXAxisStrokeColor()
+	const arrowSize, elbow = 6, 6
+	for i, row := range rows {
+		to := bounds[i]
+		if to == nil {
+			continue
+		}
+		for _, dependency := range row.task.Dependencies {
+			from := bounds[taskRows[dependency]]
+			if from == nil {
+				continue // the prior task is outside the range
+			}
+			points := []Point{
+				{X: from.endX, Y: from.centerY},
+				{X: from.endX + elbow, Y: from.centerY},
+			}
+			if to.startX-arrowSize >= from.endX+elbow {
+				points = append(points, Point{X: from.endX + elbow, Y: to.centerY})
+			} else {
+				// wrap back through the row boundary before the dependent task
+				boundaryY := yValues[to.rowIndex]
+				if to.rowIndex < from.rowIndex {
+					boundaryY = yValues[to.rowIndex+1]
+				}
+				points = append(points,
+					Point{X: from.endX + elbow, Y: boundaryY},
+					Point{X: to.startX - arrowSize - elbow, Y: boundaryY},
+					Point{X: to.startX - arrowSize - elbow, Y: to.centerY})
+			}
+			points = append(points, Point{X: to.startX - arrowSize, Y: to.centerY})
+			seriesPainter.LineStroke(points, arrowColor, 1)
+			seriesPainter.ArrowRight(to.startX, to.centerY, arrowSize, arrowSize, arrowColor, arrowColor, 1)
+		}
+	}
+
+	// time axis below the plot, the edge intervals are clipped by a configured Start or End
+	axisColor := opt.Theme.GetXAxisStrokeColor()
+	height := seriesPainter.Height()
+	seriesPainter.LineStroke([]Point{{X: 0, Y: height}, {X: width, Y: height}}, axisColor, 1)
+	for i, tick := range timeAxis.ticks {
+		if !tick.Before(axisStart) && !tick.After(axisEnd) {
+			x := xForTime(tick)
+			seriesPainter.LineStroke([]Point{{X: x, Y: height}, {X: x, Y: height + ganttAxisTickLength}},
+				axisColor, 1)
+		}
+		if i >= len(timeAxis.labels) || i+1 >= len(timeAxis.ticks) {
+			continue
+		}
+		x0, x1 := xForTime(tick), xForTime(timeAxis.ticks[i+1])
+		textBox := seriesPainter.MeasureText(timeAxis.labels[i], 0, timeAxis.fontStyle)
+		clipped := tick.Before(axisStart) || timeAxis.ticks[i+1].After(axisEnd)
+		if x1 <= x0 || (clipped && textBox.Width() > x1-x0) {
+			continue // the interval is outside the range, or too narrow for the label once clipped
+		}
+		seriesPainter.Text(timeAxis.labels[i], (x0+x1-textBox.Width())/2,
+			height+ganttAxisTickLength+ganttAxisLabelMargin+textBox.Height(), 0, timeAxis.fontStyle)
+	}
+
+	if !opt.Today.IsZero() && !opt.Today.Before(axisStart) && !opt.Today.After(axisEnd) {
+		todayColor := opt.TodayColor
+		if todayColor.IsZero() {
+			todayColor = ColorRed
+		}
+		x := xForTime(opt.Today)
+		seriesPainter.DashedLineStroke([]Point{{X: x, Y: 0}, {X: x, Y: seriesPainter.Height()}},
+			todayColor, 1, []float64{4, 2})
+	}
+	return g.p.box, nil
+}
+
+func (g *ganttChart) Render() (Box, error) {
+	p := g.p
+	opt := g.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+	legend := opt.Legend
+	if legend.Show == nil {
+		legend.Show = Ptr(false) // group headers already label the task groups
+	}
+
+	nameCounts := make(map[string]int, len(opt.Tasks))
+	for _, task := range opt.Tasks {
+		nameCounts[task.Name]++
+	}
+	for _, task := range opt.Tasks {
+		for _, dependency := range task.Dependencies {
+			if nameCounts[dependency] == 0 {
+				return BoxZero, fmt.Errorf("gantt task %q depends on unknown task %q", task.Name, dependency)
+			} else if nameCounts[dependency] > 1 {
+				return BoxZero, fmt.Errorf("gantt task %q depends on duplicate task name %q", task.Name, dependency)
+			}
+		}
+	}
+	rows, groupNames := ganttRows(opt.Tasks, !flagIs(false, opt.ShowGroupHeader))
+	rowLabels := make([]string, len(rows))
+	for i, row := range rows {
+		rowLabels[i] = row.label
+	}
+	var ticks []time.Time
+	var timeLabels []string
+	var axisStart, axisEnd time.Time
+	var xFontStyle FontStyle
+	padding := opt.Padding
+	if padding.IsZero() {
+		padding = defaultPadding
+	}
+	if len(rows) > 0 {
+		minTime, maxTime := ganttTimeRange(opt.Tasks, opt.Start, opt.End)
+		if maxTime.Before(minTime) {
+			if opt.End.IsZero() {
+				return BoxZero, errors.New("gantt Start must not be after all tasks")
+			} else if opt.Start.IsZero() {
+				return BoxZero, errors.New("gantt End must not be before all tasks")
+			}
+			return BoxZero, errors.New("gantt End must be after Start")
+		} else if maxTime.Equal(minTime) {
+			maxTime = minTime.Add(24 * time.Hour) // milestones only, show a day
+		}
+
+		// size the time axis labels to fit the estimated plot width
+		xFontStyle = fillFontStyleDefaults(opt.XAxisFontStyle, defaultFontSize,
+			opt.Theme.GetXAxisTextColor(), p.font)
+		yFontStyle := fillFontStyleDefaults(opt.YAxisFontStyle, defaultFontSize,
+			opt.Theme.GetYAxisTextColor(), p.font)
+		rowLabelWidth, _ := p.measureTextMaxWidthHeight(rowLabels, 0, yFontStyle)
+		plotWidth := p.Width() - padding.Left - padding.Right - rowLabelWidth - 20
+		for maxTicks := max(plotWidth/40, 2); ; maxTicks-- {
+			var step time.Duration
+			ticks, step = ganttTimeTicks(minTime, maxTime, maxTicks)
+			layout := opt.TimeFormat
+			if layout == "" {
+				layout = ganttTimeFormat(step, ticks[0], ticks[len(ticks)-1])
+			}
+			// labels are centered within the interval they start
+			timeLabels = make([]string, len(ticks)-1)
+			for i := range timeLabels {
+				timeLabels[i] = ticks[i].Format(layout)
+			}
+			labelWidth, _ := p.measureTextMaxWidthHeight(timeLabels, 0, xFontStyle)
+			if maxTicks <= 2 || len(timeLabels)*(labelWidth+10) <= plotWidth {
+				break
+			}
+		}
+		// the ticks only position the labels, a configured Start or End is the exact axis bound
+		axisStart, axisEnd = ticks[0], ticks[len(ticks)-1]
+		if !opt.Start.IsZero() {
+			axisStart = minTime
+		}
+		if !opt.End.IsZero() {
+			axisEnd = maxTime
+		}
+		// the time axis is drawn with the tasks, reserve the space below the plot
+		_, labelHeight := p.measureTextMaxWidthHeight(timeLabels, 0, xFontStyle)
+		padding.Bottom += max(labelHeight+ganttAxisTickLength+ganttAxisLabelMargin, minimumHorizontalAxisHeight)
+	}
+
+	// the vertical axis renders its labels bottom-up, reverse so the first row lands on top
+	slices.Reverse(rowLabels)
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeGantt, seriesNames: groupNames},
+		categoryAxis: &CategoryAxisOption{
+			Show:           Ptr(len(rows) == 0),
+			Labels:         timeLabels,
+			LabelCount:     len(timeLabels),
+			BoundaryGap:    Ptr(true),
+			LabelFontStyle: opt.XAxisFontStyle,
+		},
+		valueAxis: []ValueAxisOption{{
+			Labels:         rowLabels,
+			LabelCount:     len(rowLabels),
+			LabelFontStyle: opt.YAxisFontStyle,
+			isCategoryAxis: true,
+		}},
+		title:  opt.Title,
+		legend: &legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	} else if len(rows) == 0 {
+		renderResult.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	return g.renderChart(renderResult, rows, ganttTimeAxis{
+		start: axisStart, end: axisEnd, ticks: ticks, labels: timeLabels, fontStyle: xFontStyle,
+	})
+}
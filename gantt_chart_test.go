@@ -0,0 +1,242 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ganttDay(month time.Month, day int) time.Time {
+	return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func makeBasicGanttTasks() []GanttTask {
+	return []GanttTask{
+		{Name: "Requirements", Group: "Planning", Start: ganttDay(3, 3), End: ganttDay(3, 10)},
+		{Name: "Design", Group: "Planning", Start: ganttDay(3, 10), End: ganttDay(3, 21),
+			Dependencies: []string{"Requirements"}},
+		{Name: "Backend", Group: "Build", Start: ganttDay(3, 17), End: ganttDay(4, 11),
+			Dependencies: []string{"Design"}},
+		{Name: "Frontend", Group: "Build", Start: ganttDay(3, 24), End: ganttDay(4, 18),
+			Dependencies: []string{"Design"}},
+		{Name: "QA", Group: "Release", Start: ganttDay(4, 14), End: ganttDay(4, 25),
+			Dependencies: []string{"Backend", "Frontend"}},
+		{Name: "Launch", Group: "Release", Start: ganttDay(4, 28), Dependencies: []string{"QA"}},
+	}
+}
+
+func TestNewGanttChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewGanttChartOptionWithData(makeBasicGanttTasks())
+
+	assert.Len(t, opt.Tasks, 6)
+	assert.Equal(t, defaultPadding, opt.Padding)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.GanttChart(opt))
+}
+
+func TestGanttRows(t *testing.T) {
+	t.Parallel()
+
+	tasks := []GanttTask{
+		{Name: "a", Group: "x", Start: ganttDay(1, 5), End: ganttDay(1, 8)},
+		{Name: "b", Start: ganttDay(1, 1), End: ganttDay(1, 2)},
+		{Name: "c", Group: "x", Start: ganttDay(1, 2), End: ganttDay(1, 4)},
+		{Name: "d", Group: "y", Start: ganttDay(1, 9)},
+	}
+
+	rows, names := ganttRows(tasks, true)
+	assert.Equal(t, []string{"x", "y"}, names)
+	labels := make([]string, len(rows))
+	for i, row := range rows {
+		labels[i] = row.label
+	}
+	assert.Equal(t, []string{"x", "a", "c", "b", "y", "d"}, labels)
+	assert.Nil(t, rows[0].task)
+	assert.Equal(t, ganttDay(1, 2), rows[0].start)
+	assert.Equal(t, ganttDay(1, 8), rows[0].end)
+	assert.Equal(t, 0, rows[3].groupIndex)
+	assert.Equal(t, 1, rows[5].groupIndex)
+	assert.True(t, rows[5].task.isMilestone())
+
+	rows, _ = ganttRows(tasks, false)
+	assert.Len(t, rows, 4)
+}
+
+func TestGanttTimeTicks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hours", func(t *testing.T) {
+		start := time.Date(2025, 3, 4, 9, 30, 0, 0, time.UTC)
+		ticks, step := ganttTimeTicks(start, start.Add(5*time.Hour), 10)
+		assert.Equal(t, time.Hour, step)
+		assert.Equal(t, time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC), ticks[0])
+		assert.Len(t, ticks, 7)
+	})
+	t.Run("days", func(t *testing.T) {
+		start := time.Date(2025, 3, 4, 9, 30, 0, 0, time.UTC)
+		ticks, step := ganttTimeTicks(start, start.Add(6*24*time.Hour), 8)
+		assert.Equal(t, 24*time.Hour, step)
+		assert.Equal(t, ganttDay(3, 4), ticks[0])
+		assert.True(t, ticks[len(ticks)-1].After(start.Add(6*24*time.Hour)))
+	})
+	t.Run("half_hour_zone", func(t *testing.T) {
+		zone := time.FixedZone("IST", 5*60*60+30*60)
+		start := time.Date(2025, 3, 4, 9, 45, 0, 0, zone)
+		ticks, _ := ganttTimeTicks(start, start.Add(5*time.Hour), 10)
+		assert.Equal(t, time.Date(2025, 3, 4, 9, 0, 0, 0, zone), ticks[0])
+	})
+	t.Run("days_across_dst", func(t *testing.T) {
+		zone, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		start := time.Date(2025, 3, 6, 9, 0, 0, 0, zone)
+		ticks, step := ganttTimeTicks(start, start.AddDate(0, 0, 6), 8)
+		assert.Equal(t, 24*time.Hour, step)
+		for _, tick := range ticks {
+			assert.Equal(t, 0, tick.Hour())
+		}
+		assert.Equal(t, 10, ticks[4].Day()) // after the DST change on the 9th
+	})
+	t.Run("weeks", func(t *testing.T) {
+		ticks, step := ganttTimeTicks(ganttDay(3, 6), ganttDay(4, 20), 8)
+		assert.Equal(t, 7*24*time.Hour, step)
+		assert.Equal(t, ganttDay(3, 3), ticks[0]) // Monday
+		assert.Equal(t, time.Monday, ticks[0].Weekday())
+		assert.Equal(t, ganttDay(4, 21), ticks[len(ticks)-1])
+	})
+}
+
+func TestGanttChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() GanttChartOption
+	}{
+		{
+			name: "basic",
+			makeOptions: func() GanttChartOption {
+				opt := NewGanttChartOptionWithData(makeBasicGanttTasks())
+				opt.Title = TitleOption{Text: "Release plan"}
+				opt.Today = ganttDay(4, 2)
+				return opt
+			},
+		},
+		{
+			name: "legend_no_header_dark_theme",
+			makeOptions: func() GanttChartOption {
+				opt := NewGanttChartOptionWithData(makeBasicGanttTasks())
+				opt.ShowGroupHeader = Ptr(false)
+				opt.Legend.Show = Ptr(true)
+				opt.Theme = GetTheme(ThemeVividDark)
+				opt.Today = ganttDay(3, 20)
+				opt.TodayColor = ColorYellow
+				return opt
+			},
+		},
+		{
+			name: "hourly_time_format",
+			makeOptions: func() GanttChartOption {
+				start := time.Date(2025, 6, 2, 8, 0, 0, 0, time.UTC)
+				opt := NewGanttChartOptionWithData([]GanttTask{
+					{Name: "Deploy", Start: start, End: start.Add(90 * time.Minute)},
+					{Name: "Verify", Start: start.Add(2 * time.Hour), End: start.Add(5 * time.Hour),
+						Dependencies: []string{"Deploy"}},
+					{Name: "Rollback window", Start: start.Add(time.Hour), End: start.Add(7 * time.Hour),
+						Color: ColorGray},
+				})
+				opt.TimeFormat = "15:04"
+				opt.BarSize = 0.3
+				return opt
+			},
+		},
+		{
+			name: "range_override",
+			makeOptions: func() GanttChartOption {
+				opt := NewGanttChartOptionWithData(makeBasicGanttTasks())
+				opt.Start = ganttDay(2, 1)
+				opt.End = ganttDay(6, 30)
+				opt.XAxisFontStyle = FontStyle{FontSize: 8}
+				opt.YAxisFontStyle = FontStyle{FontColor: ColorBlue}
+				return opt
+			},
+		},
+		{
+			name: "milestones_only",
+			makeOptions: func() GanttChartOption {
+				return NewGanttChartOptionWithData([]GanttTask{
+					{Name: "Freeze", Start: ganttDay(5, 1).Add(6 * time.Hour)},
+					{Name: "Ship", Start: ganttDay(5, 1).Add(18 * time.Hour), Dependencies: []string{"Freeze"}},
+				})
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() GanttChartOption {
+				return NewGanttChartOptionWithData(nil)
+			},
+		},
+		{
+			name: "range_narrowed",
+			makeOptions: func() GanttChartOption {
+				opt := NewGanttChartOptionWithData(makeBasicGanttTasks())
+				opt.Start = ganttDay(3, 14)
+				opt.End = ganttDay(4, 20)
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        800,
+				Height:       400,
+			})
+			require.NoError(t, p.GanttChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestGanttChartError(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        800,
+		Height:       400,
+	})
+	err := p.GanttChart(NewGanttChartOptionWithData([]GanttTask{
+		{Name: "a", Start: ganttDay(1, 1), End: ganttDay(1, 3), Dependencies: []string{"missing"}},
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `gantt task "a" depends on unknown task "missing"`)
+
+	err = p.GanttChart(NewGanttChartOptionWithData([]GanttTask{
+		{Name: "a", Start: ganttDay(1, 1), End: ganttDay(1, 3)},
+		{Name: "a", Start: ganttDay(1, 2), End: ganttDay(1, 4)},
+		{Name: "b", Start: ganttDay(1, 5), End: ganttDay(1, 6), Dependencies: []string{"a"}},
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `gantt task "b" depends on duplicate task name "a"`)
+
+	opt := NewGanttChartOptionWithData([]GanttTask{{Name: "a", Start: ganttDay(1, 1), End: ganttDay(1, 3)}})
+	opt.Start = ganttDay(2, 1)
+	err = p.GanttChart(opt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gantt Start must not be after all tasks")
+
+	opt.Start, opt.End = ganttDay(1, 3), ganttDay(1, 2)
+	err = p.GanttChart(opt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gantt End must be after Start")
+}
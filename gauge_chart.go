@@ -0,0 +1,343 @@
+package charts
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	defaultGaugeSplitCount      = 10
+	defaultGaugeMinorSplitCount = 5
+	defaultGaugeValueFontSize   = 24.0
+)
+
+// GaugeThreshold colors the gauge scale from the prior threshold (or the gauge minimum) up to Value.
+type GaugeThreshold struct {
+	// Value is the upper bound of the band.
+	Value float64
+	// Color is the band color.
+	Color Color
+}
+
+// GaugeChartOption defines the options for rendering a gauge chart. Render the chart using Painter.GaugeChart.
+type GaugeChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Value is the current value indicated by the needle or progress arc.
+	Value float64
+	// Name is an optional description rendered below the center value.
+	Name string
+	// Min is the value at the start of the scale. Default is 0.
+	Min *float64
+	// Max is the value at the end of the scale. Default is 100.
+	Max *float64
+	// StartAngle is the angle in degrees the scale starts at, with 0 at three o'clock and increasing
+	// counterclockwise. Default is 225.
+	StartAngle *float64
+	// EndAngle is the angle in degrees the scale ends at, the scale runs clockwise from StartAngle. Default is -45.
+	EndAngle *float64
+	// RadiusRing sets the outer radius of the scale arc, for example "40%". Default fits the arc to the chart.
+	RadiusRing string
+	// ArcWidth specifies the pixel width of the scale arc. Default is 12% of the radius.
+	ArcWidth float64
+	// SplitCount specifies the number of major tick intervals. Default is 10.
+	SplitCount int
+	// MinorSplitCount specifies the number of minor tick intervals within each major interval. Default is 5.
+	MinorSplitCount int
+	// ShowTickLabels when set to *false hides the value labels at each major tick.
+	ShowTickLabels *bool
+	// LabelFontStyle specifies the font for the tick labels.
+	LabelFontStyle FontStyle
+	// Thresholds provides colored bands along the scale, in ascending order of Value. The scale beyond the last
+	// threshold uses the theme axis split line color.
+	Thresholds []GaugeThreshold
+	// ShowNeedle when set to *false hides the needle pointing at the value.
+	ShowNeedle *bool
+	// ShowProgress when set to *true fills the scale arc from the minimum to the value. With Thresholds set, the
+	// bands beyond the value are faded instead.
+	ShowProgress *bool
+	// IndicatorColor overrides the needle and progress color. Defaults to the threshold color at the value, or
+	// the first theme series color without thresholds.
+	IndicatorColor Color
+	// ShowValue when set to *false hides the center value.
+	ShowValue *bool
+	// ValueFontStyle specifies the font for the center value.
+	ValueFontStyle FontStyle
+	// ValueFormatter defines how float values are rendered to strings, for the center value and tick labels.
+	ValueFormatter ValueFormatter
+}
+
+type gaugeChart struct {
+	p   *Painter
+	opt *GaugeChartOption
+}
+
+// newGaugeChart returns a gauge chart renderer.
+func newGaugeChart(p *Painter, opt GaugeChartOption) *gaugeChart {
+	return &gaugeChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewGaugeChartOptionWithData returns an initialized GaugeChartOption with the provided value.
+func NewGaugeChartOptionWithData(value float64) GaugeChartOption {
+	return GaugeChartOption{
+		Value:          value,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// gaugeScale maps values to screen angles along the scale.
+type gaugeScale struct {
+	minValue, maxValue float64
+	startRadians       float64 // screen angle, increasing clockwise
+	deltaRadians       float64
+}
+
+// angle returns the screen angle for the value, clamped to the scale.
+func (g gaugeScale) angle(value float64) float64 {
+	percent := (value - g.minValue) / (g.maxValue - g.minValue)
+	percent = min(max(percent, 0), 1)
+	return g.startRadians + percent*g.deltaRadians
+}
+
+// gaugeUnitBounds returns the bounds of a unit radius arc sweeping clockwise on screen from startRadians,
+// including the center point.
+func gaugeUnitBounds(startRadians, deltaRadians float64) (minX, maxX, minY, maxY float64) {
+	extend := func(a float64) {
+		minX, maxX = min(minX, math.Cos(a)), max(maxX, math.Cos(a))
+		minY, maxY = min(minY, math.Sin(a)), max(maxY, math.Sin(a))
+	}
+	extend(startRadians)
+	extend(startRadians + deltaRadians)
+	// include any axis extremes crossed by the arc
+	first := math.Ceil(startRadians / (math.Pi / 2))
+	for k := first; k*math.Pi/2 <= startRadians+deltaRadians; k++ {
+		extend(k * math.Pi / 2)
+	}
+	return minX, maxX, minY, maxY
+}
+
+func (g *gaugeChart) renderChart(result *defaultRenderResult) (Box, error) {
+	opt := g.opt
+	seriesPainter := result.seriesPainter
+	scale := gaugeScale{
+		minValue: 0,
+		maxValue: 100,
+	}
+	if opt.Min != nil {
+		scale.minValue = *opt.Min
+	}
+	if opt.Max != nil {
+		scale.maxValue = *opt.Max
+	}
+	if scale.maxValue <= scale.minValue {
+		return BoxZero, errors.New("gauge Max must be greater than Min")
+	}
+	startAngle, endAngle := 225.0, -45.0
+	if opt.StartAngle != nil {
+		startAngle = *opt.StartAngle
+	}
+	if opt.EndAngle != nil {
+		endAngle = *opt.EndAngle
+	}
+	if startAngle <= endAngle || startAngle-endAngle > 360 {
+		return BoxZero, errors.New("gauge StartAngle must be greater than EndAngle by at most 360 degrees")
+	}
+	scale.startRadians = -startAngle * math.Pi / 180
+	scale.deltaRadians = (startAngle - endAngle) * math.Pi / 180
+
+	showNeedle := !flagIs(false, opt.ShowNeedle)
+	showValue := !flagIs(false, opt.ShowValue)
+	valueFormatter := getPreferredValueFormatter(opt.ValueFormatter)
+	valueFontStyle := fillFontStyleDefaults(opt.ValueFontStyle, defaultGaugeValueFontSize,
+		opt.Theme.GetTitleTextColor(), seriesPainter.font)
+	nameFontStyle := fillFontStyleDefaults(FontStyle{}, defaultLabelFontSize,
+		opt.Theme.GetLabelTextColor(), seriesPainter.font)
+	var valueText string
+	var valueBox, nameBox Box
+	if showValue {
+		valueText = valueFormatter(opt.Value)
+		valueBox = seriesPainter.MeasureText(valueText, 0, valueFontStyle)
+	}
+	if opt.Name != "" {
+		nameBox = seriesPainter.MeasureText(opt.Name, 0, nameFontStyle)
+	}
+
+	// fit the arc to the available space, reserving room below the center for the value text
+	width, height := float64(seriesPainter.Width()), float64(seriesPainter.Height())
+	minX, maxX, minY, maxY := gaugeUnitBounds(scale.startRadians, scale.deltaRadians)
+	textHeight := float64(valueBox.Height() + nameBox.Height())
+	textOffset, textBelow := 0.0, textHeight/2 // text is centered on the pivot without a needle
+	if showNeedle {
+		textOffset, textBelow = 0.35, textHeight
+	}
+	radius := min(width/(maxX-minX), height/(maxY-minY), (height-textBelow)/(textOffset-minY))
+	if opt.RadiusRing != "" {
+		radius = getFlexibleRadius(min(width, height), 0.45, opt.RadiusRing)
+	}
+	bottom := max(maxY*radius, textOffset*radius+textBelow)
+	cx := int(math.Round((width-(maxX-minX)*radius)/2 - minX*radius))
+	cy := int(math.Round((height-(bottom-minY*radius))/2 - minY*radius))
+	arcWidth := opt.ArcWidth
+	if arcWidth <= 0 {
+		arcWidth = max(radius*0.12, 2)
+	}
+	arcRadius := radius - arcWidth/2
+	if radius <= 0 || arcRadius <= 0 {
+		return BoxZero, errors.New("insufficient space for gauge chart")
+	}
+
+	// scale track and threshold bands
+	showProgress := flagIs(true, opt.ShowProgress)
+	drawBand := func(from, to float64, color Color) {
+		if showProgress && len(opt.Thresholds) > 0 && to > opt.Value {
+			// progress is shown by fading the band beyond the value
+			split := max(from, opt.Value)
+			g.strokeArc(seriesPainter, cx, cy, arcRadius, scale.angle(from), scale.angle(split), color, arcWidth)
+			g.strokeArc(seriesPainter, cx, cy, arcRadius, scale.angle(split), scale.angle(to),
+				color.WithAlpha(64), arcWidth)
+		} else {
+			g.strokeArc(seriesPainter, cx, cy, arcRadius, scale.angle(from), scale.angle(to), color, arcWidth)
+		}
+	}
+	indicatorColor := opt.Theme.GetSeriesColor(0)
+	priorValue := scale.minValue
+	for _, threshold := range opt.Thresholds {
+		if threshold.Value <= priorValue {
+			continue
+		}
+		drawBand(priorValue, threshold.Value, threshold.Color)
+		if opt.Value >= priorValue && opt.Value <= threshold.Value {
+			indicatorColor = threshold.Color
+		}
+		priorValue = threshold.Value
+	}
+	if priorValue < scale.maxValue {
+		drawBand(priorValue, scale.maxValue, opt.Theme.GetAxisSplitLineColor())
+	}
+	if !opt.IndicatorColor.IsZero() {
+		indicatorColor = opt.IndicatorColor
+	}
+	if showProgress && len(opt.Thresholds) == 0 && opt.Value > scale.minValue {
+		g.strokeArc(seriesPainter, cx, cy, arcRadius, scale.startRadians, scale.angle(opt.Value),
+			indicatorColor, arcWidth)
+	}
+
+	// ticks and tick labels
+	splitCount := getDefaultInt(opt.SplitCount, defaultGaugeSplitCount)
+	minorSplitCount := getDefaultInt(opt.MinorSplitCount, defaultGaugeMinorSplitCount)
+	tickColor := opt.Theme.GetXAxisStrokeColor()
+	tickOuter := radius - arcWidth - 2
+	majorLength := max(radius*0.06, 4)
+	minorLength := majorLength / 2
+	labelFontStyle := fillFontStyleDefaults(opt.LabelFontStyle, defaultLabelFontSize,
+		opt.Theme.GetXAxisTextColor(), seriesPainter.font)
+	showTickLabels := !flagIs(false, opt.ShowTickLabels)
+	tickCount := splitCount * minorSplitCount
+	for i := 0; i <= tickCount; i++ {
+		angle := scale.startRadians + scale.deltaRadians*float64(i)/float64(tickCount)
+		isMajor := i%minorSplitCount == 0
+		length, strokeWidth := minorLength, 1.0
+		if isMajor {
+			length, strokeWidth = majorLength, 2.0
+		}
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		seriesPainter.LineStroke([]Point{
+			{X: cx + int(math.Round(tickOuter*cos)), Y: cy + int(math.Round(tickOuter*sin))},
+			{X: cx + int(math.Round((tickOuter-length)*cos)), Y: cy + int(math.Round((tickOuter-length)*sin))},
+		}, tickColor, strokeWidth)
+
+		// a full circle scale would overlap the first and last label
+		if !isMajor || !showTickLabels || (i == tickCount && scale.deltaRadians >= 2*math.Pi) {
+			continue
+		}
+		value := scale.minValue + (scale.maxValue-scale.minValue)*float64(i)/float64(tickCount)
+		text := valueFormatter(value)
+		textBox := seriesPainter.MeasureText(text, 0, labelFontStyle)
+		labelRadius := tickOuter - majorLength - 4 - float64(max(textBox.Width(), textBox.Height()))/2
+		x := cx + int(math.Round(labelRadius*cos)) - textBox.Width()/2
+		y := cy + int(math.Round(labelRadius*sin)) + textBox.Height()/2
+		seriesPainter.Text(text, x, y, 0, labelFontStyle)
+	}
+
+	if showNeedle {
+		angle := scale.angle(opt.Value)
+		length := tickOuter - majorLength/2
+		halfWidth := max(radius*0.04, 3)
+		cos, sin := math.Cos(angle), math.Sin(angle)
+		tip := Point{X: cx + int(math.Round(length*cos)), Y: cy + int(math.Round(length*sin))}
+		seriesPainter.FillArea([]Point{
+			tip,
+			{X: cx + int(math.Round(-halfWidth*sin)), Y: cy + int(math.Round(halfWidth*cos))},
+			{X: cx - int(math.Round(halfWidth*2*cos)), Y: cy - int(math.Round(halfWidth*2*sin))},
+			{X: cx + int(math.Round(halfWidth*sin)), Y: cy - int(math.Round(halfWidth*cos))},
+			tip,
+		}, indicatorColor)
+		seriesPainter.Circle(halfWidth*1.5, cx, cy, indicatorColor, indicatorColor, 0)
+	}
+
+	textY := cy + int(math.Round(textOffset*radius))
+	if !showNeedle {
+		textY -= int(textHeight) / 2
+	}
+	if valueText != "" {
+		textY += valueBox.Height()
+		seriesPainter.Text(valueText, cx-valueBox.Width()/2, textY, 0, valueFontStyle)
+	}
+	if opt.Name != "" {
+		textY += nameBox.Height() + 2
+		seriesPainter.Text(opt.Name, cx-nameBox.Width()/2, textY, 0, nameFontStyle)
+	}
+	return g.p.box, nil
+}
+
+// strokeArc strokes an arc centered on the radius between the screen angles.
+func (g *gaugeChart) strokeArc(p *Painter, cx, cy int, radius, startRadians, endRadians float64,
+	color Color, width float64) {
+	if endRadians <= startRadians {
+		return
+	} else if endRadians-startRadians >= 2*math.Pi-1e-9 {
+		p.Circle(radius, cx, cy, ColorTransparent, color, width)
+		return
+	}
+	p.arcTo(cx, cy, radius, radius, startRadians, endRadians-startRadians)
+	p.stroke(color, width)
+}
+
+func (g *gaugeChart) Render() (Box, error) {
+	p := g.p
+	opt := g.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeGauge},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title: opt.Title,
+		legend: &LegendOption{
+			Show: Ptr(false),
+		},
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return g.renderChart(renderResult)
+}
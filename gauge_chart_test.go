@@ -0,0 +1,195 @@
+package charts
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGaugeChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewGaugeChartOptionWithData(42)
+
+	assert.InDelta(t, 42.0, opt.Value, 0)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.ValueFormatter)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.GaugeChart(opt))
+}
+
+func TestGaugeScaleAngle(t *testing.T) {
+	t.Parallel()
+
+	scale := gaugeScale{minValue: 10, maxValue: 20, startRadians: -math.Pi, deltaRadians: math.Pi}
+	assert.InDelta(t, -math.Pi, scale.angle(10), 1e-9)
+	assert.InDelta(t, -math.Pi/2, scale.angle(15), 1e-9)
+	assert.InDelta(t, 0.0, scale.angle(20), 1e-9)
+	assert.InDelta(t, -math.Pi, scale.angle(-5), 1e-9)
+	assert.InDelta(t, 0.0, scale.angle(50), 1e-9)
+}
+
+func TestGaugeUnitBounds(t *testing.T) {
+	t.Parallel()
+
+	// upper semicircle from nine to three o'clock
+	minX, maxX, minY, maxY := gaugeUnitBounds(-math.Pi, math.Pi)
+	assert.InDelta(t, -1.0, minX, 1e-9)
+	assert.InDelta(t, 1.0, maxX, 1e-9)
+	assert.InDelta(t, -1.0, minY, 1e-9)
+	assert.InDelta(t, 0.0, maxY, 1e-9)
+
+	// default 270 degree scale leaves a gap at the bottom
+	minX, maxX, minY, maxY = gaugeUnitBounds(-225*math.Pi/180, 270*math.Pi/180)
+	assert.InDelta(t, -1.0, minX, 1e-9)
+	assert.InDelta(t, 1.0, maxX, 1e-9)
+	assert.InDelta(t, -1.0, minY, 1e-9)
+	assert.InDelta(t, math.Sqrt2/2, maxY, 1e-9)
+}
+
+func TestGaugeChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() GaugeChartOption
+	}{
+		{
+			name: "basic",
+			makeOptions: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(72.5)
+				opt.Name = "Utilization %"
+				return opt
+			},
+		},
+		{
+			name: "thresholds_progress_semicircle",
+			makeOptions: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(0.82)
+				opt.Title = TitleOption{Text: "SLO burn"}
+				opt.Theme = GetTheme(ThemeVividDark)
+				opt.Min = Ptr(0.0)
+				opt.Max = Ptr(1.0)
+				opt.StartAngle = Ptr(180.0)
+				opt.EndAngle = Ptr(0.0)
+				opt.Thresholds = []GaugeThreshold{
+					{Value: 0.6, Color: ColorGreen},
+					{Value: 0.85, Color: ColorOrange},
+					{Value: 1, Color: ColorRed},
+				}
+				opt.ShowProgress = Ptr(true)
+				return opt
+			},
+		},
+		{
+			name: "progress_ring",
+			makeOptions: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(40)
+				opt.StartAngle = Ptr(90.0)
+				opt.EndAngle = Ptr(-270.0)
+				opt.ShowNeedle = Ptr(false)
+				opt.ShowProgress = Ptr(true)
+				opt.ValueFormatter = func(f float64) string {
+					return FormatValueHumanize(f, 0, false) + "%"
+				}
+				return opt
+			},
+		},
+		{
+			name: "custom_scale",
+			makeOptions: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(3200)
+				opt.Max = Ptr(8000.0)
+				opt.RadiusRing = "40%"
+				opt.ArcWidth = 6
+				opt.SplitCount = 8
+				opt.MinorSplitCount = 2
+				opt.LabelFontStyle = FontStyle{FontSize: 8, FontColor: ColorBlue}
+				opt.ValueFontStyle = FontStyle{FontSize: 16}
+				opt.Name = "RPM"
+				return opt
+			},
+		},
+		{
+			name: "indicator_color_clamped",
+			makeOptions: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(140)
+				opt.IndicatorColor = ColorPurple
+				opt.ShowTickLabels = Ptr(false)
+				opt.ShowValue = Ptr(false)
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.GaugeChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestGaugeChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		makeOption func() GaugeChartOption
+		errorMsg   string
+	}{
+		{
+			name: "max_below_min",
+			makeOption: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(1)
+				opt.Min = Ptr(10.0)
+				opt.Max = Ptr(5.0)
+				return opt
+			},
+			errorMsg: "Max must be greater than Min",
+		},
+		{
+			name: "reversed_angles",
+			makeOption: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(1)
+				opt.StartAngle = Ptr(0.0)
+				opt.EndAngle = Ptr(180.0)
+				return opt
+			},
+			errorMsg: "StartAngle must be greater than EndAngle",
+		},
+		{
+			name: "insufficient_space",
+			makeOption: func() GaugeChartOption {
+				opt := NewGaugeChartOptionWithData(1)
+				opt.ValueFontStyle = FontStyle{FontSize: 400}
+				return opt
+			},
+			errorMsg: "insufficient space",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			err := p.GaugeChart(tt.makeOption())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
@@ -120,13 +120,7 @@ func (h *heatMap) renderChart(result *defaultRenderResult) (Box, error) {
 			if x < len(opt.Values[y]) {
 				value = opt.Values[y][x]
 			}
-			ratio := (value - minVal) / valueRange
-			lightDelta := (1 - ratio) * 0.4
-			satDelta := (1 - ratio) * 0.1
-			if opt.Theme.IsDark() {
-				lightDelta *= -1
-			}
-			cellColor := baseColor.WithAdjustHSL(0, satDelta, lightDelta)
+			cellColor := heatMapCellColor(baseColor, (value-minVal)/valueRange, opt.Theme.IsDark())
 
 			seriesPainter.FilledRect(xValues[x], yValues[y], xValues[x+1], yValues[y+1],
 				cellColor, cellColor, 0)
@@ -162,6 +156,16 @@ func (h *heatMap) renderChart(result *defaultRenderResult) (Box, error) {
 	return seriesPainter.box, nil
 }
 
+// heatMapCellColor adjusts the lightness of the base color by the ratio of the value within the color scale.
+func heatMapCellColor(baseColor Color, ratio float64, dark bool) Color {
+	lightDelta := (1 - ratio) * 0.4
+	satDelta := (1 - ratio) * 0.1
+	if dark {
+		lightDelta *= -1
+	}
+	return baseColor.WithAdjustHSL(0, satDelta, lightDelta)
+}
+
 func computeMinMax(values [][]float64, numCol int) (float64, float64) {
 	if len(values) == 0 || numCol == 0 {
 		return 0, 0
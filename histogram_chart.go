@@ -0,0 +1,410 @@
+package charts
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/go-analyze/bulk"
+)
+
+const (
+	// HistogramBinSturges selects the bin count using Sturges' rule, ceil(log2(n)) + 1.
+	HistogramBinSturges = "sturges"
+	// HistogramBinScott selects the bin width using Scott's normal reference rule, 3.49σn^(-1/3).
+	HistogramBinScott = "scott"
+	// HistogramBinFreedmanDiaconis selects the bin width using the Freedman–Diaconis rule, 2·IQR·n^(-1/3).
+	HistogramBinFreedmanDiaconis = "fd"
+
+	// HistogramNormalizationCount plots the number of samples in each bin.
+	HistogramNormalizationCount = "count"
+	// HistogramNormalizationDensity plots the probability density, so that the bar areas sum to one.
+	HistogramNormalizationDensity = "density"
+	// HistogramNormalizationCumulative plots the running count of samples up to the end of each bin.
+	HistogramNormalizationCumulative = "cumulative"
+
+	histogramMaxBins       = 1000
+	histogramKDEPointCount = 100
+)
+
+// HistogramOption defines the options for rendering a histogram chart. Render the chart using Painter.HistogramChart.
+type HistogramOption struct {
+	// Theme specifies the colors used for the histogram. Bars use the first series color, the KDE line the second.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Samples provides the raw sample values which are binned when rendered. Null values are ignored.
+	Samples []float64
+	// Name specifies a name for the histogram, shown in the legend when set.
+	Name string
+	// BinMethod selects the automatic binning rule: HistogramBinSturges (default), HistogramBinScott,
+	// or HistogramBinFreedmanDiaconis. Automatic bin widths are rounded to a nice interval.
+	BinMethod string
+	// BinWidth sets an explicit bin width, overriding BinMethod. Bin edges are aligned to multiples of the width.
+	BinWidth float64
+	// BinCount sets an explicit bin count, overriding BinMethod. Bins evenly span the sample range.
+	BinCount int
+	// Normalization selects the plotted bar value: HistogramNormalizationCount (default),
+	// HistogramNormalizationDensity, or HistogramNormalizationCumulative.
+	Normalization string
+	// ShowKDE when *true overlays a Gaussian kernel density estimate line, scaled to match the bars.
+	ShowKDE *bool
+	// KDEBandwidth overrides the KDE bandwidth, otherwise Silverman's rule of thumb is used.
+	KDEBandwidth *float64
+	// KDEStrokeWidth is the stroke width of the KDE line. Default 2.0.
+	KDEStrokeWidth float64
+	// XAxis contains options for the numeric x-axis. Labels are generated from the bin edges.
+	XAxis XAxisOption
+	// YAxis contains options for the y-axis.
+	YAxis YAxisOption
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the data legend.
+	Legend LegendOption
+	// Label provides the labels for each bar.
+	Label SeriesLabel
+	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
+	ValueFormatter ValueFormatter
+}
+
+type histogramChart struct {
+	p   *Painter
+	opt *HistogramOption
+}
+
+// newHistogramChart returns a histogram chart renderer.
+func newHistogramChart(p *Painter, opt HistogramOption) *histogramChart {
+	return &histogramChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewHistogramOptionWithSamples returns an initialized HistogramOption which bins the provided samples.
+func NewHistogramOptionWithSamples(samples []float64) HistogramOption {
+	return HistogramOption{
+		Samples:        samples,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// histogramBins describes evenly sized bins starting at start. The last bin includes its upper edge.
+type histogramBins struct {
+	start  float64
+	width  float64
+	counts []int
+}
+
+// edge returns the lower edge of bin i, or the upper edge of the last bin when i == len(counts).
+func (b histogramBins) edge(i int) float64 {
+	return b.start + float64(i)*b.width
+}
+
+// computeHistogramBins bins the valid sample values according to the explicit width or count, or the method rule.
+func computeHistogramBins(samples []float64, method string, binWidth float64, binCount int) (histogramBins, error) {
+	sorted := bulk.SliceFilter(isValidExtent, samples)
+	if len(sorted) == 0 {
+		return histogramBins{}, nil
+	} else if binWidth < 0 || math.IsNaN(binWidth) || math.IsInf(binWidth, 0) {
+		return histogramBins{}, errors.New("invalid histogram bin width")
+	} else if binCount < 0 || binCount > histogramMaxBins {
+		return histogramBins{}, errors.New("invalid histogram bin count")
+	}
+	slices.Sort(sorted)
+	n := float64(len(sorted))
+	minValue, maxValue := sorted[0], sorted[len(sorted)-1]
+	valueRange := maxValue - minValue
+
+	var bins histogramBins
+	if valueRange == 0 {
+		bins.width = binWidth
+		if bins.width == 0 {
+			bins.width = 1
+		}
+		bins.start = minValue - bins.width/2
+		bins.counts = []int{len(sorted)}
+		return bins, nil
+	}
+
+	if binWidth == 0 && binCount > 0 {
+		bins.start = minValue
+		bins.width = valueRange / float64(binCount)
+		bins.counts = make([]int, binCount)
+	} else {
+		width := binWidth
+		if width == 0 {
+			switch method {
+			case "", HistogramBinSturges:
+				// fall through to the Sturges default below
+			case HistogramBinScott:
+				width = 3.49 * summarizePopulationData(sorted).StandardDeviation * math.Pow(n, -1.0/3)
+			case HistogramBinFreedmanDiaconis:
+				iqr := sortedQuantile(sorted, 0.75) - sortedQuantile(sorted, 0.25)
+				width = 2 * iqr * math.Pow(n, -1.0/3)
+			default:
+				return histogramBins{}, errors.New("invalid histogram bin method")
+			}
+			if width <= 0 { // Sturges, or a degenerate spread estimate
+				width = valueRange / (math.Ceil(math.Log2(n)) + 1)
+			}
+			width = niceNum(width)
+		}
+		bins.width = width
+		bins.start = math.Floor(minValue/width) * width
+		// checked before the int conversion, which overflows for a tiny width
+		count := math.Ceil((maxValue - bins.start) / width)
+		if count > histogramMaxBins {
+			return histogramBins{}, errors.New("histogram bin width produces too many bins")
+		}
+		bins.counts = make([]int, max(int(count), 1))
+	}
+
+	lastBin := len(bins.counts) - 1
+	for _, v := range sorted {
+		i := int((v - bins.start) / bins.width)
+		bins.counts[max(0, min(i, lastBin))]++
+	}
+	return bins, nil
+}
+
+// histogramValues converts the bin counts to the plotted values for the normalization mode.
+func histogramValues(bins histogramBins, normalization string) ([]float64, error) {
+	var total int
+	for _, c := range bins.counts {
+		total += c
+	}
+	values := make([]float64, len(bins.counts))
+	switch normalization {
+	case "", HistogramNormalizationCount:
+		for i, c := range bins.counts {
+			values[i] = float64(c)
+		}
+	case HistogramNormalizationDensity:
+		for i, c := range bins.counts {
+			values[i] = float64(c) / (float64(total) * bins.width)
+		}
+	case HistogramNormalizationCumulative:
+		var sum int
+		for i, c := range bins.counts {
+			sum += c
+			values[i] = float64(sum)
+		}
+	default:
+		return nil, errors.New("invalid histogram normalization")
+	}
+	return values, nil
+}
+
+// histogramKDEPoints returns [x, y] points of the sample density, scaled to the histogram normalization.
+func histogramKDEPoints(samples []float64, bins histogramBins, normalization string, bandwidth *float64) [][2]float64 {
+	filtered := bulk.SliceFilter(isValidExtent, samples)
+	density := gaussianKDE(filtered, histogramKDEPointCount, bandwidth)
+	if len(density) == 0 {
+		return nil
+	}
+	summary := summarizePopulationData(filtered)
+	n := float64(len(filtered))
+	step := (summary.Max - summary.Min) / float64(len(density)-1)
+
+	points := make([][2]float64, len(density))
+	var cumulative float64
+	for i, d := range density {
+		x := summary.Min + float64(i)*step
+		var y float64
+		switch normalization {
+		case HistogramNormalizationDensity:
+			y = d
+		case HistogramNormalizationCumulative:
+			if i > 0 { // trapezoid integration of the density
+				cumulative += (density[i-1] + d) / 2 * step
+			}
+			y = cumulative * n
+		default:
+			y = d * n * bins.width
+		}
+		points[i] = [2]float64{x, y}
+	}
+	return points
+}
+
+func (h *histogramChart) renderChart(result *defaultRenderResult, bins histogramBins, values []float64,
+	kdePoints [][2]float64) (Box, error) {
+	p := h.p
+	opt := h.opt
+	if len(values) == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	seriesPainter := result.seriesPainter
+	valueRange := result.valueAxisRanges[0]
+	plotHeight := seriesPainter.Height()
+	xEdges := autoDivide(seriesPainter.Width(), len(values))
+
+	barColor := opt.Theme.GetSeriesColor(0)
+	var borderWidth float64
+	if seriesPainter.Width()/len(values) >= 4 {
+		borderWidth = 1 // separate contiguous bars with a thin background border
+	}
+	var labelPainter *seriesLabelPainter
+	if flagIs(true, opt.Label.Show) {
+		labelPainter = newSeriesLabelPainter(seriesPainter, []string{opt.Name}, opt.Label, opt.Theme, opt.Padding.Right)
+	}
+	for i, v := range values {
+		top := valueRange.getRestHeight(v)
+		if v > 0 {
+			seriesPainter.FilledRect(xEdges[i], top, xEdges[i+1], plotHeight,
+				barColor, opt.Theme.GetBackgroundColor(), borderWidth)
+		}
+		if labelPainter != nil {
+			labelPainter.Add(labelValue{
+				dataIndex: i,
+				value:     v,
+				x:         (xEdges[i] + xEdges[i+1]) >> 1,
+				y:         top,
+				vertical:  true,
+				fontStyle: opt.Label.FontStyle,
+				offset:    opt.Label.Offset,
+			})
+		}
+	}
+
+	if len(kdePoints) > 0 {
+		strokeWidth := opt.KDEStrokeWidth
+		if strokeWidth <= 0 {
+			strokeWidth = 2
+		}
+		span := bins.edge(len(values)) - bins.start
+		width := float64(seriesPainter.Width())
+		points := make([]Point, len(kdePoints))
+		for i, pt := range kdePoints {
+			points[i] = Point{
+				X: int(math.Round((pt[0] - bins.start) / span * width)),
+				Y: valueRange.getRestHeight(pt[1]),
+			}
+		}
+		seriesPainter.LineStroke(points, opt.Theme.GetSeriesColor(1), strokeWidth)
+	}
+
+	if labelPainter != nil {
+		if _, err := labelPainter.Render(); err != nil {
+			return BoxZero, err
+		}
+	}
+	return p.box, nil
+}
+
+func (h *histogramChart) Render() (Box, error) {
+	p := h.p
+	opt := h.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+
+	bins, err := computeHistogramBins(opt.Samples, opt.BinMethod, opt.BinWidth, opt.BinCount)
+	if err != nil {
+		return BoxZero, err
+	}
+	values, err := histogramValues(bins, opt.Normalization)
+	if err != nil {
+		return BoxZero, err
+	}
+	var kdePoints [][2]float64
+	if flagIs(true, opt.ShowKDE) && len(values) > 0 {
+		kdePoints = histogramKDEPoints(opt.Samples, bins, opt.Normalization, opt.KDEBandwidth)
+	}
+
+	// bin edges are labeled on tick marks, producing a numeric axis with the bin width as the interval
+	xAxis := opt.XAxis
+	xAxis.BoundaryGap = Ptr(false)
+	xAxis.Labels = make([]string, len(values)+1)
+	edgeFormatter := getPreferredValueFormatter(opt.XAxis.ValueFormatter, opt.ValueFormatter)
+	for i := range xAxis.Labels {
+		xAxis.Labels[i] = edgeFormatter(bins.edge(i))
+	}
+	yAxis := opt.YAxis
+	if yAxis.Min == nil {
+		yAxis.Min = Ptr(0.0) // bars always grow from zero
+	}
+
+	rangeValues := slices.Clone(values)
+	for _, pt := range kdePoints {
+		rangeValues = append(rangeValues, pt[1])
+	}
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:          opt.Theme,
+		padding:        opt.Padding,
+		seriesList:     valuesFakeSeries{name: opt.Name, values: rangeValues},
+		categoryAxis:   &xAxis,
+		valueAxis:      []ValueAxisOption{yAxis},
+		title:          opt.Title,
+		legend:         &opt.Legend,
+		valueFormatter: opt.ValueFormatter,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return h.renderChart(renderResult, bins, values, kdePoints)
+}
+
+// valuesFakeSeries is a single series of the rendered values, used to drive defaultRender's axis ranging and legend.
+type valuesFakeSeries struct {
+	name   string
+	values []float64
+}
+
+func (v valuesFakeSeries) len() int {
+	return 1
+}
+
+func (v valuesFakeSeries) getSeries(_ int) series {
+	return v
+}
+
+func (v valuesFakeSeries) getSeriesName(_ int) string {
+	return v.name
+}
+
+func (v valuesFakeSeries) getSeriesValues(_ int) []float64 {
+	return v.values
+}
+
+func (v valuesFakeSeries) getSeriesLen(_ int) int {
+	return 0 // category labels are fully provided from the bin edges
+}
+
+func (v valuesFakeSeries) names() []string {
+	return []string{v.name}
+}
+
+func (v valuesFakeSeries) markPointSize() int {
+	return 0
+}
+
+func (v valuesFakeSeries) setSeriesName(_ int, _ string) {
+	// ignored, the name is configured through the chart option
+}
+
+func (v valuesFakeSeries) sortByNameIndex(_ map[string]int) {
+	// no-op
+}
+
+func (v valuesFakeSeries) getSeriesSymbol(_ int) SymbolShape {
+	return ""
+}
+
+func (v valuesFakeSeries) getType() string {
+	return ChartTypeBar
+}
+
+func (v valuesFakeSeries) getYAxisIndex() int {
+	return 0
+}
+
+func (v valuesFakeSeries) getValues() []float64 {
+	return v.values // all rendered values so they fit in the value range
+}
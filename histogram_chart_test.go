@@ -0,0 +1,291 @@
+package charts
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeHistogramSamples(count int) []float64 {
+	r := rand.New(rand.NewSource(42))
+	samples := make([]float64, count)
+	for i := range samples {
+		samples[i] = r.NormFloat64()*12 + 50
+	}
+	return samples
+}
+
+func TestNewHistogramOptionWithSamples(t *testing.T) {
+	t.Parallel()
+
+	opt := NewHistogramOptionWithSamples([]float64{1, 2, 2, 3})
+
+	assert.Len(t, opt.Samples, 4)
+	assert.Equal(t, defaultPadding, opt.Padding)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.HistogramChart(opt))
+}
+
+func TestComputeHistogramBins(t *testing.T) {
+	t.Parallel()
+
+	samples := []float64{1, 2, 2, 3, 3, 3, 4, 4, 5, 9}
+
+	t.Run("sturges", func(t *testing.T) {
+		bins, err := computeHistogramBins(samples, "", 0, 0)
+		require.NoError(t, err)
+		// 8 / 5 = 1.6 rounds up to a width of 2
+		assert.InDelta(t, 2.0, bins.width, 1e-12)
+		assert.InDelta(t, 0.0, bins.start, 1e-12)
+		assert.Equal(t, []int{1, 5, 3, 0, 1}, bins.counts)
+	})
+	t.Run("explicit_width", func(t *testing.T) {
+		bins, err := computeHistogramBins(samples, HistogramBinScott, 3, 0)
+		require.NoError(t, err)
+		assert.InDelta(t, 0.0, bins.start, 1e-12)
+		assert.Equal(t, []int{3, 6, 1}, bins.counts)
+	})
+	t.Run("explicit_count", func(t *testing.T) {
+		bins, err := computeHistogramBins(samples, "", 0, 4)
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, bins.start, 1e-12)
+		assert.InDelta(t, 2.0, bins.width, 1e-12)
+		assert.Equal(t, []int{3, 5, 1, 1}, bins.counts)
+		assert.InDelta(t, 9.0, bins.edge(4), 1e-12)
+	})
+	t.Run("scott", func(t *testing.T) {
+		bins, err := computeHistogramBins(samples, HistogramBinScott, 0, 0)
+		require.NoError(t, err)
+		assert.InDelta(t, 5.0, bins.width, 1e-12)
+		assert.Equal(t, []int{8, 2}, bins.counts)
+	})
+	t.Run("freedman_diaconis", func(t *testing.T) {
+		bins, err := computeHistogramBins(samples, HistogramBinFreedmanDiaconis, 0, 0)
+		require.NoError(t, err)
+		assert.InDelta(t, 2.0, bins.width, 1e-12)
+		assert.Len(t, bins.counts, 5)
+	})
+	t.Run("constant_samples", func(t *testing.T) {
+		bins, err := computeHistogramBins([]float64{4, 4, 4}, "", 0, 0)
+		require.NoError(t, err)
+		assert.InDelta(t, 3.5, bins.start, 1e-12)
+		assert.Equal(t, []int{3}, bins.counts)
+	})
+	t.Run("null_samples", func(t *testing.T) {
+		bins, err := computeHistogramBins([]float64{GetNullValue(), math.NaN()}, "", 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, bins.counts)
+	})
+}
+
+func TestHistogramValues(t *testing.T) {
+	t.Parallel()
+
+	bins := histogramBins{start: 0, width: 2, counts: []int{1, 3, 4, 2}}
+
+	values, err := histogramValues(bins, "")
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 3, 4, 2}, values)
+
+	values, err = histogramValues(bins, HistogramNormalizationCumulative)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 4, 8, 10}, values)
+
+	values, err = histogramValues(bins, HistogramNormalizationDensity)
+	require.NoError(t, err)
+	var area float64
+	for _, v := range values {
+		area += v * bins.width
+	}
+	assert.InDelta(t, 1.0, area, 1e-12)
+}
+
+func TestHistogramKDEPoints(t *testing.T) {
+	t.Parallel()
+
+	samples := makeHistogramSamples(200)
+	bins, err := computeHistogramBins(samples, "", 0, 0)
+	require.NoError(t, err)
+
+	points := histogramKDEPoints(samples, bins, HistogramNormalizationCumulative, nil)
+	require.Len(t, points, histogramKDEPointCount)
+	assert.InDelta(t, 0.0, points[0][1], 1e-12)
+	// the integrated density approaches the sample count, minus the tails cut beyond the sample range
+	assert.InDelta(t, 200.0, points[len(points)-1][1], 10)
+	for i := 1; i < len(points); i++ {
+		assert.GreaterOrEqual(t, points[i][1], points[i-1][1])
+	}
+
+	assert.Nil(t, histogramKDEPoints([]float64{1, 1, 1}, bins, "", nil))
+}
+
+func TestHistogramChart(t *testing.T) {
+	t.Parallel()
+
+	samples := makeHistogramSamples(300)
+	tests := []struct {
+		name        string
+		makeOptions func() HistogramOption
+	}{
+		{
+			name: "basic",
+			makeOptions: func() HistogramOption {
+				return NewHistogramOptionWithSamples(samples)
+			},
+		},
+		{
+			name: "kde",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples(samples)
+				opt.ShowKDE = Ptr(true)
+				return opt
+			},
+		},
+		{
+			name: "density_kde",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples(samples)
+				opt.BinMethod = HistogramBinFreedmanDiaconis
+				opt.Normalization = HistogramNormalizationDensity
+				opt.ShowKDE = Ptr(true)
+				opt.KDEBandwidth = Ptr(3.0)
+				return opt
+			},
+		},
+		{
+			name: "cumulative_kde",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples(samples)
+				opt.BinMethod = HistogramBinScott
+				opt.Normalization = HistogramNormalizationCumulative
+				opt.ShowKDE = Ptr(true)
+				return opt
+			},
+		},
+		{
+			name: "labels_title_legend",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples(samples)
+				opt.BinWidth = 10
+				opt.Name = "Response time"
+				opt.Title = TitleOption{Text: "Histogram"}
+				opt.Legend = LegendOption{Offset: OffsetRight}
+				opt.Label.Show = Ptr(true)
+				opt.XAxis.Title = "ms"
+				return opt
+			},
+		},
+		{
+			name: "bin_count",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples(samples)
+				opt.BinCount = 40
+				opt.KDEStrokeWidth = 1
+				opt.ShowKDE = Ptr(true)
+				return opt
+			},
+		},
+		{
+			name: "constant_samples",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples([]float64{5, 5, 5})
+				opt.ShowKDE = Ptr(true)
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() HistogramOption {
+				return NewHistogramOptionWithSamples(nil)
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.HistogramChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestHistogramChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		makeOptions      func() HistogramOption
+		errorMsgContains string
+	}{
+		{
+			name: "invalid_method",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples([]float64{1, 2, 3})
+				opt.BinMethod = "rice"
+				return opt
+			},
+			errorMsgContains: "invalid histogram bin method",
+		},
+		{
+			name: "invalid_normalization",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples([]float64{1, 2, 3})
+				opt.Normalization = "percent"
+				return opt
+			},
+			errorMsgContains: "invalid histogram normalization",
+		},
+		{
+			name: "negative_width",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples([]float64{1, 2, 3})
+				opt.BinWidth = -1
+				return opt
+			},
+			errorMsgContains: "invalid histogram bin width",
+		},
+		{
+			name: "too_many_bins",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples([]float64{1, 2, 3000})
+				opt.BinWidth = 0.1
+				return opt
+			},
+			errorMsgContains: "too many bins",
+		},
+		{
+			name: "tiny_width_overflow",
+			makeOptions: func() HistogramOption {
+				opt := NewHistogramOptionWithSamples([]float64{1, 2, 3000})
+				opt.BinWidth = 1e-300
+				return opt
+			},
+			errorMsgContains: "too many bins",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			err := p.HistogramChart(tt.makeOptions())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsgContains)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package charts
 
 import (
+	"fmt"
 	"math"
 	"slices"
 )
@@ -49,6 +50,13 @@ type LineChartOption struct {
 	// This forces FillArea and ignores options like StrokeSmoothingTension.
 	// Only the first y-axis is stacked, and MarkLine only renders for the first series on it.
 	StackSeries *bool
+	// StackPercent when *true renders series stacked as above, with the values at each index scaled to their
+	// percentage share so the stack sums to 100%. The y-axis is fixed to 0-100% and labels default to the share.
+	// Negative values are treated as zero, and error bars are scaled with the values.
+	StackPercent *bool
+	// StackPercentRawLabels when *true with StackPercent renders the original series values in the labels, rather
+	// than the percentage share.
+	StackPercentRawLabels *bool
 	// XAxis contains options for the x-axis.
 	XAxis XAxisOption
 	// YAxis contains options for the y-axis. At most two y-axes are supported.
@@ -70,12 +78,65 @@ type LineChartOption struct {
 	FillArea *bool
 	// FillOpacity is the opacity/alpha (0-255) of the area fill.
 	FillOpacity uint8
+	// Step renders the lines as piecewise constant steps, set to LineStepBefore, LineStepAfter, or LineStepMiddle.
+	// Step takes priority over StrokeSmoothingTension, and can be overridden per series. Other values return an error.
+	Step string
+	// Bands provides filled ranges rendered beneath the lines, such as confidence intervals or min/max envelopes.
+	Bands []BandSeries
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
 }
 
+// BandSeries fills the range between a lower and upper value at each x-axis position of a line chart. Band values
+// are not stacked, and the band is split where either value is null. Values beyond the x-axis are ignored.
+type BandSeries struct {
+	// Lower provides the values for the bottom edge of the band.
+	Lower []float64
+	// Upper provides the values for the top edge of the band.
+	Upper []float64
+	// YAxisIndex is the index for the axis, it must be 0 or 1 and match an axis used by the line series.
+	YAxisIndex int
+	// Color sets the band color. Default is the theme series color matching the band index, so the first band
+	// matches the first line series.
+	Color Color
+	// FillOpacity is the opacity/alpha (0-255) of the band fill. Default is 64.
+	FillOpacity uint8
+	// StrokeEdges when set to *true draws a line along the lower and upper edges of the band.
+	StrokeEdges *bool
+	// StrokeWidth is the width of the edge lines. Default is 1.
+	StrokeWidth float64
+}
+
+// lineBandSeriesList includes the band values in the value axis range of the series.
+type lineBandSeriesList struct {
+	seriesList
+	bands []BandSeries
+}
+
+func (l lineBandSeriesList) extentValues(yAxisIndex int, stacked bool) []float64 {
+	var values []float64
+	if el, ok := l.seriesList.(seriesExtentList); ok {
+		values = el.extentValues(yAxisIndex, stacked)
+	}
+	for _, band := range l.bands {
+		if band.YAxisIndex == yAxisIndex {
+			values = append(append(values, band.Lower...), band.Upper...)
+		}
+	}
+	return values
+}
+
 const showSymbolDefaultThreshold = 100
 
+const (
+	// LineStepBefore steps to each value at the prior x-axis position, the value applies to the interval before it.
+	LineStepBefore = "before"
+	// LineStepAfter holds each value until the next x-axis position, the value applies to the interval after it.
+	LineStepAfter = "after"
+	// LineStepMiddle steps to each value halfway between x-axis positions.
+	LineStepMiddle = "middle"
+)
+
 func boundaryGapAxisPositions(painterWidth int, boundaryGap bool, xDivideCount int) []int {
 	if !boundaryGap {
 		xDivideCount--
@@ -93,15 +154,30 @@ func boundaryGapAxisPositions(painterWidth int, boundaryGap bool, xDivideCount i
 	return xValues
 }
 
+// lineSeriesStep returns the step of the series, defaulting to the chart step.
+func lineSeriesStep(series LineSeries, step string) string {
+	if series.Step != "" {
+		return series.Step
+	}
+	return step
+}
+
 func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 	p := l.p
 	opt := l.opt
 	seriesCount := len(opt.SeriesList)
-	if seriesCount == 0 {
+	if seriesCount == 0 && len(opt.Bands) == 0 {
 		result.renderNoData(opt.Theme)
 		return p.box, nil
 	}
 	seriesPainter := result.seriesPainter
+	for _, series := range opt.SeriesList {
+		switch step := lineSeriesStep(series, opt.Step); step {
+		case "", LineStepBefore, LineStepAfter, LineStepMiddle:
+		default:
+			return BoxZero, fmt.Errorf("unsupported line Step %q", step)
+		}
+	}
 
 	stackedSeries := flagIs(true, opt.StackSeries)
 	fillAreaY0 := stackedSeries // fill area defaults to on if the series is stacked
@@ -132,12 +208,9 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		strokeWidth = defaultStrokeWidth
 	}
 	symbol := opt.Symbol
-	if symbol.Shape == "" {
-		showSymbol := dataCount < showSymbolDefaultThreshold // default enable when data count is reasonable
-		if opt.StrokeSmoothingTension > 0 {
-			showSymbol = false // default disable symbols on curved lines since the dots won't hit the line exactly
-		}
-		if showSymbol {
+	defaultSymbol := symbol.Shape == ""
+	if defaultSymbol {
+		if dataCount < showSymbolDefaultThreshold { // default enable when data count is reasonable
 			symbol.Shape = SymbolCircle
 		} else {
 			symbol.Shape = SymbolNone
@@ -148,7 +221,14 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 	markPointPainter := newMarkPointPainter(seriesPainter)
 	markLinePainter := newMarkLinePainter(seriesPainter)
 	trendLinePainter := newTrendLinePainter(seriesPainter)
-	rendererList := []renderer{markPointPainter, markLinePainter, trendLinePainter}
+	errorBarPainter := newErrorBarPainter(seriesPainter)
+	rendererList := []renderer{markPointPainter, markLinePainter, trendLinePainter, errorBarPainter}
+
+	for index, band := range opt.Bands {
+		if err := l.renderBand(seriesPainter, result, xValues, index, band); err != nil {
+			return BoxZero, err
+		}
+	}
 
 	seriesNames := opt.SeriesList.names()
 	// stacking is limited to the first y-axis, so the bounds may not be the first and last series
@@ -189,13 +269,35 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 				labelPainter.Add(labelValue{
 					index:     index,
 					dataIndex: i,
-					value:     item,
+					value:     labelValueAt(series.labelValues, i, item),
 					x:         points[i].X,
 					y:         points[i].Y,
 				})
 			}
 		}
 
+		if series.ErrorBar.isSet() {
+			errorColor, errorStrokeWidth, capWidth := errorBarStyle(series.ErrorBar, seriesColor, 8)
+			stdDevError := series.ErrorBar.stdDevError(series.Values)
+			for i, item := range series.Values {
+				low, high, ok := series.ErrorBar.bounds(i, item, stdDevError)
+				if !ok {
+					continue
+				} else if stackSeries { // offset to the accumulated stack value
+					low += accumulatedValues[i] - item
+					high += accumulatedValues[i] - item
+				}
+				errorBarPainter.add(errorBarRenderOption{
+					center:      xValues[i],
+					low:         yRange.getRestHeight(low),
+					high:        yRange.getRestHeight(high),
+					capWidth:    capWidth,
+					color:       errorColor,
+					strokeWidth: errorStrokeWidth,
+				})
+			}
+		}
+
 		var stackLinePoints []Point
 		if stackSeries {
 			// accumulated stack line, valid at every index even where this series is null
@@ -204,14 +306,21 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 				stackLinePoints[i] = Point{X: xValues[i], Y: yRange.getRestHeight(v)}
 			}
 		}
+		// step lines insert corner points, the original points remain for symbols and marks
+		step := lineSeriesStep(series, opt.Step)
+		linePoints, stackEdgePoints := points, stackLinePoints
+		if step != "" {
+			linePoints = stepPathPoints(points, step)
+			stackEdgePoints = stepPathPoints(stackLinePoints, step)
+		}
 
 		if (series.YAxisIndex == 0 && fillAreaY0) || fillAreaY1 {
 			var areaPoints []Point
 			if stackSeries {
 				// top edge follows the accumulated line so null values collapse onto the stack below
-				areaPoints = slices.Clone(stackLinePoints)
+				areaPoints = slices.Clone(stackEdgePoints)
 			} else {
-				areaPoints = slices.Clone(points)
+				areaPoints = slices.Clone(linePoints)
 				for i, p := range areaPoints {
 					if p.Y != math.MaxInt32 {
 						if i > 0 {
@@ -249,8 +358,8 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 			}
 			fillColor := seriesColor.WithAlpha(opacity)
 
-			// If smoothing is enabled, do a smooth fill (not currently supported for stacked series)
-			if !stackSeries && opt.StrokeSmoothingTension > 0 {
+			// If smoothing is enabled, do a smooth fill (not currently supported for stacked or step series)
+			if !stackSeries && step == "" && opt.StrokeSmoothingTension > 0 {
 				seriesPainter.smoothFillChartArea(areaPoints, opt.StrokeSmoothingTension, fillColor)
 			} else {
 				seriesPainter.FillArea(areaPoints, fillColor)
@@ -258,16 +367,19 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		}
 
 		// Draw the line
-		if opt.StrokeSmoothingTension > 0 {
+		if step == "" && opt.StrokeSmoothingTension > 0 {
 			seriesPainter.SmoothLineStroke(points, opt.StrokeSmoothingTension, seriesColor, strokeWidth)
 		} else {
-			seriesPainter.LineStroke(points, seriesColor, strokeWidth)
+			seriesPainter.LineStroke(linePoints, seriesColor, strokeWidth)
 		}
 
 		// Draw symbols if enabled
 		seriesSymbol := symbol
 		if series.Symbol.Shape != "" {
 			seriesSymbol.Shape = series.Symbol.Shape
+		} else if defaultSymbol && step == "" && opt.StrokeSmoothingTension > 0 {
+			// default disable symbols on curved lines since the dots won't hit the line exactly
+			seriesSymbol.Shape = SymbolNone
 		}
 		symbolSize := series.Symbol.Size
 		if symbolSize <= 0 {
@@ -399,7 +511,7 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 
 		if stackSeries {
 			// save the accumulated line for the next series to stack onto
-			priorSeriesPoints = stackLinePoints
+			priorSeriesPoints = stackEdgePoints
 		}
 	}
 
@@ -409,12 +521,69 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 	return p.box, nil
 }
 
+// renderBand fills each continuous run of valid lower and upper values, optionally stroking the edges.
+func (l *lineChart) renderBand(p *Painter, result *defaultRenderResult, xValues []int, index int, band BandSeries) error {
+	yRange, ok := result.valueAxisRanges[band.YAxisIndex]
+	if !ok {
+		return fmt.Errorf("band %d specified invalid y-axis index %d", index, band.YAxisIndex)
+	}
+	color := band.Color
+	if color.IsZero() {
+		color = l.opt.Theme.GetSeriesColor(index)
+	}
+	var opacity uint8 = 64
+	if band.FillOpacity > 0 {
+		opacity = band.FillOpacity
+	}
+	strokeWidth := band.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1
+	}
+
+	count := min(len(band.Lower), len(band.Upper), len(xValues))
+	var lower, upper []Point
+	flush := func() {
+		if len(lower) > 0 {
+			area := slices.Clone(upper)
+			for i := len(lower) - 1; i >= 0; i-- {
+				area = append(area, lower[i])
+			}
+			area = append(area, area[0]) // close the shape
+			p.FillArea(area, color.WithAlpha(opacity))
+			if flagIs(true, band.StrokeEdges) {
+				p.LineStroke(lower, color, strokeWidth)
+				p.LineStroke(upper, color, strokeWidth)
+			}
+		}
+		lower, upper = lower[:0], upper[:0]
+	}
+	for i := 0; i < count; i++ {
+		if !isValidExtent(band.Lower[i]) || !isValidExtent(band.Upper[i]) {
+			flush()
+			continue
+		}
+		lower = append(lower, Point{X: xValues[i], Y: yRange.getRestHeight(band.Lower[i])})
+		upper = append(upper, Point{X: xValues[i], Y: yRange.getRestHeight(band.Upper[i])})
+	}
+	flush()
+	return nil
+}
+
 func (l *lineChart) Render() (Box, error) {
 	p := l.p
 	opt := l.opt
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if flagIs(true, opt.StackPercent) {
+		opt.StackSeries = Ptr(true)
+		opt.SeriesList = opt.SeriesList.stackPercent(flagIs(true, opt.StackPercentRawLabels))
+		opt.YAxis = slices.Clone(opt.YAxis) // cloned so the percent axis doesn't modify the caller's slice
+		if len(opt.YAxis) == 0 {
+			opt.YAxis = []YAxisOption{{}}
+		}
+		opt.YAxis[0] = stackPercentValueAxis(opt.YAxis[0])
+	}
 	// boundary gap default must be set here as it's used by the x-axis as well
 	if opt.XAxis.BoundaryGap == nil {
 		fillArea := flagIs(true, opt.StackSeries) // fill area default based on StackedSeries state
@@ -438,7 +607,7 @@ func (l *lineChart) Render() (Box, error) {
 	renderResult, err := defaultRender(p, defaultRenderOption{
 		theme:          opt.Theme,
 		padding:        opt.Padding,
-		seriesList:     opt.SeriesList,
+		seriesList:     lineBandSeriesList{seriesList: opt.SeriesList, bands: opt.Bands},
 		stackSeries:    flagIs(true, opt.StackSeries),
 		categoryAxis:   &l.opt.XAxis,
 		valueAxis:      opt.YAxis,
@@ -1321,6 +1321,200 @@ func validateLineChartRender(t *testing.T, svgP, pngP *Painter, opt LineChartOpt
 	assertEqualPNGCRC(t, expectedCRC, rdata)
 }
 
+func TestLineChartBands(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+		{220, 182, 191, 234, 290, 330, 310},
+	}
+	tests := []struct {
+		name        string
+		makeOptions func() LineChartOption
+	}{
+		{
+			name: "confidence_interval",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData(values[:1])
+				opt.Bands = []BandSeries{{
+					Lower: []float64{100, 110, 80, 115, 60, 200, 170},
+					Upper: []float64{140, 160, 120, 150, 120, 270, 260},
+				}}
+				return opt
+			},
+		},
+		{
+			name: "stroked_edges_with_null",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData(values)
+				opt.Bands = []BandSeries{
+					{
+						Lower:       []float64{100, 110, 80, GetNullValue(), 60, 200, 170},
+						Upper:       []float64{140, 160, 120, 150, 120, 270, 260},
+						StrokeEdges: Ptr(true),
+					},
+					{
+						Lower:       []float64{200, 160, 170, 210, 250, 300, 290},
+						Upper:       []float64{240, 200, 210, 260, 330, 380, 340},
+						Color:       ColorPurple,
+						FillOpacity: 120,
+						StrokeEdges: Ptr(true),
+						StrokeWidth: 2,
+					},
+				}
+				return opt
+			},
+		},
+		{
+			name: "secondary_axis_range",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData(values)
+				opt.SeriesList[1].YAxisIndex = 1
+				opt.YAxis = make([]YAxisOption, 2)
+				opt.Bands = []BandSeries{{
+					Lower:      []float64{100, 50, 0, 150, 200, 250, 200, 100},
+					Upper:      []float64{400, 450, 500, 550, 600, 650, 700, 750},
+					YAxisIndex: 1,
+					Color:      opt.Theme.GetSeriesColor(1),
+				}}
+				opt.FillArea = Ptr(true)
+				opt.FillOpacity = 100
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.LineChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestLineChartBandsGeneric(t *testing.T) {
+	t.Parallel()
+
+	seriesList := NewSeriesListGeneric([][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+		{80, 90, 70, 110, 60, 150, 140},
+	}, ChartTypeLine)
+	seriesList[1].Type = ChartTypeBar
+
+	p, err := Render(ChartOption{
+		OutputFormat: ChartOutputSVG,
+		SeriesList:   seriesList,
+		Bands: []BandSeries{{
+			Lower: []float64{100, 110, 80, 115, 60, 200, 170},
+			Upper: []float64{140, 160, 120, 150, 120, 270, 300},
+		}},
+	})
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+}
+
+func TestLineChartStep(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{
+		{120, 132, 101, GetNullValue(), 90, 230, 210},
+		{220, 182, 191, 234, 290, 330, 310},
+		{50, 80, 60, 70, 40, 90, 100},
+	}
+	tests := []struct {
+		name        string
+		makeOptions func() LineChartOption
+	}{
+		{
+			name: "series_modes",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData(values)
+				opt.Step = LineStepAfter
+				opt.SeriesList[1].Step = LineStepBefore
+				opt.SeriesList[2].Step = LineStepMiddle
+				opt.SeriesList[2].Symbol.Shape = SymbolSquare
+				opt.Legend.SeriesNames = []string{"after", "before", "middle"}
+				return opt
+			},
+		},
+		{
+			name: "fill_area_smoothing_ignored",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData(values[1:])
+				opt.Step = LineStepBefore
+				opt.FillArea = Ptr(true)
+				opt.StrokeSmoothingTension = 0.8
+				return opt
+			},
+		},
+		{
+			name: "stacked",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData(values)
+				opt.Step = LineStepAfter
+				opt.StackSeries = Ptr(true)
+				opt.SeriesList[2].Step = LineStepMiddle
+				return opt
+			},
+		},
+		{
+			name: "smoothed_with_series_step",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData(values)
+				opt.StrokeSmoothingTension = 0.8
+				opt.SeriesList[1].Step = LineStepAfter
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.LineChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestLineChartStepGeneric(t *testing.T) {
+	t.Parallel()
+
+	lineSeries := NewSeriesListLine([][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+		{220, 182, 191, 234, 290, 330, 310},
+	})
+	lineSeries[1].Step = LineStepBefore
+	seriesList := lineSeries.ToGenericSeriesList()
+	assert.Equal(t, LineStepBefore, seriesList[1].Step)
+	assert.Equal(t, LineStepBefore, filterSeriesList[LineSeriesList](seriesList, ChartTypeLine)[1].Step)
+
+	p, err := Render(ChartOption{
+		OutputFormat: ChartOutputSVG,
+		SeriesList:   seriesList,
+		Step:         LineStepAfter,
+	})
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+}
+
 func TestLineChartError(t *testing.T) {
 	t.Parallel()
 
@@ -1347,6 +1541,33 @@ func TestLineChartError(t *testing.T) {
 			},
 			errorMsgContains: "invalid y-axis index",
 		},
+		{
+			name: "band_yaxis_index",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData([][]float64{{1, 2, 3}})
+				opt.Bands = []BandSeries{{Lower: []float64{0, 1, 2}, Upper: []float64{2, 3, 4}, YAxisIndex: 1}}
+				return opt
+			},
+			errorMsgContains: "invalid y-axis index",
+		},
+		{
+			name: "invalid_step",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData([][]float64{{1, 2, 3}})
+				opt.Step = "start"
+				return opt
+			},
+			errorMsgContains: "unsupported line Step",
+		},
+		{
+			name: "invalid_series_step",
+			makeOptions: func() LineChartOption {
+				opt := NewLineChartOptionWithData([][]float64{{1, 2, 3}, {3, 2, 1}})
+				opt.SeriesList[1].Step = "end"
+				return opt
+			},
+			errorMsgContains: "unsupported line Step",
+		},
 	}
 
 	for i, tt := range tests {
@@ -0,0 +1,238 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// marimekkoAxisIntervals is the number of label intervals on both percentage axes.
+const marimekkoAxisIntervals = 5
+
+// MarimekkoSeries provides a segment (sub-category) of a Marimekko chart, with a value for each category column.
+type MarimekkoSeries struct {
+	// Name specifies the segment name, shown in the legend.
+	Name string
+	// Values provides the segment value for each category. Null values are treated as zero.
+	Values []float64
+}
+
+// MarimekkoChartOption defines the options for rendering a Marimekko (mosaic) chart. Each category is rendered as
+// a column with a width proportional to the category total, stacked with segments sized by their share of the
+// category. Both axes show percentages. Render the chart using Painter.MarimekkoChart.
+type MarimekkoChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the segment legend.
+	Legend LegendOption
+	// SeriesList provides the segments for each column, the first stacked at the bottom.
+	SeriesList []MarimekkoSeries
+	// Categories specifies the column names, rendered above each column where they fit.
+	Categories []string
+	// AxisFontStyle specifies the font for the category names and the percentage axis labels.
+	AxisFontStyle FontStyle
+	// Label configures the segment labels, which default to the segment percentage of the column and are only
+	// rendered where they fit within the segment. Set Show to *false to hide. The LabelFormatter receives the category
+	// index with the series name.
+	Label SeriesLabel
+}
+
+type marimekkoChart struct {
+	p   *Painter
+	opt *MarimekkoChartOption
+}
+
+// newMarimekkoChart returns a Marimekko chart renderer.
+func newMarimekkoChart(p *Painter, opt MarimekkoChartOption) *marimekkoChart {
+	return &marimekkoChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewMarimekkoChartOptionWithData returns an initialized MarimekkoChartOption with a segment series for each set of
+// values. The first dimension of the values indicates the segment, while the second provides the value for each
+// category column.
+func NewMarimekkoChartOptionWithData(values [][]float64) MarimekkoChartOption {
+	seriesList := make([]MarimekkoSeries, len(values))
+	for i, v := range values {
+		seriesList[i] = MarimekkoSeries{Values: v}
+	}
+	return MarimekkoChartOption{
+		SeriesList: seriesList,
+		Padding:    defaultPadding,
+		Theme:      GetDefaultTheme(),
+	}
+}
+
+// marimekkoTotals returns the total of each category column along with the grand total.
+func marimekkoTotals(seriesList []MarimekkoSeries) ([]float64, float64, error) {
+	var categoryCount int
+	for _, series := range seriesList {
+		categoryCount = max(categoryCount, len(series.Values))
+	}
+	totals := make([]float64, categoryCount)
+	var total float64
+	for i, series := range seriesList {
+		for j, v := range series.Values {
+			if !isValidExtent(v) {
+				continue
+			} else if v < 0 {
+				return nil, 0, fmt.Errorf("unsupported negative value at series index %d", i)
+			}
+			totals[j] += v
+			total += v
+		}
+	}
+	return totals, total, nil
+}
+
+func (m *marimekkoChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := m.p
+	opt := m.opt
+	seriesPainter := result.seriesPainter
+	totals, total, err := marimekkoTotals(opt.SeriesList)
+	if err != nil {
+		return BoxZero, err
+	} else if total == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+
+	axisStyle := fillFontStyleDefaults(opt.AxisFontStyle, defaultFontSize, opt.Theme.GetXAxisTextColor(),
+		seriesPainter.font)
+	percentLabels := make([]string, marimekkoAxisIntervals+1)
+	for i := range percentLabels {
+		percentLabels[i] = FormatValueHumanize(float64(i*100/marimekkoAxisIntervals), 0, false) + "%"
+	}
+	const labelMargin = 6
+	labelWidth, labelHeight := seriesPainter.measureTextMaxWidthHeight(percentLabels, 0, axisStyle)
+	var nameHeight int
+	if len(opt.Categories) != 0 {
+		_, nameHeight = seriesPainter.measureTextMaxWidthHeight(opt.Categories, 0, axisStyle)
+		nameHeight += labelMargin
+	}
+	left := labelWidth + labelMargin
+	right := seriesPainter.Width() - labelWidth/2
+	top := max(nameHeight, labelHeight/2)
+	bottom := seriesPainter.Height() - labelHeight - labelMargin
+	if right-left < len(totals) || bottom-top < len(opt.SeriesList) {
+		return BoxZero, errors.New("insufficient space for Marimekko chart")
+	}
+
+	showLabels := !flagIs(false, opt.Label.Show)
+	labelStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize, Color{}, seriesPainter.font)
+	borderColor := opt.Theme.GetBackgroundColor()
+	var cumulative float64
+	for i, categoryTotal := range totals {
+		x0 := left + int(math.Round(cumulative/total*float64(right-left)))
+		cumulative += categoryTotal
+		x1 := left + int(math.Round(cumulative/total*float64(right-left)))
+		if categoryTotal == 0 {
+			continue
+		}
+		if i < len(opt.Categories) && opt.Categories[i] != "" {
+			nameBox := seriesPainter.MeasureText(opt.Categories[i], 0, axisStyle)
+			if nameBox.Width() <= x1-x0 {
+				seriesPainter.Text(opt.Categories[i], (x0+x1-nameBox.Width())/2, nameHeight-labelMargin, 0, axisStyle)
+			}
+		}
+
+		var categoryCumulative float64
+		for seriesIndex, series := range opt.SeriesList {
+			if i >= len(series.Values) || !isValidExtent(series.Values[i]) || series.Values[i] == 0 {
+				continue
+			}
+			value := series.Values[i]
+			y1 := bottom - int(math.Round(categoryCumulative/categoryTotal*float64(bottom-top)))
+			categoryCumulative += value
+			y0 := bottom - int(math.Round(categoryCumulative/categoryTotal*float64(bottom-top)))
+			color := opt.Theme.GetSeriesColor(seriesIndex)
+			seriesPainter.FilledRect(x0, y0, x1, y1, color, borderColor, 1)
+			if !showLabels {
+				continue
+			}
+
+			share := value / categoryTotal * 100
+			var text string
+			fontStyle := labelStyle
+			if opt.Label.LabelFormatter != nil {
+				var style *LabelStyle
+				text, style = opt.Label.LabelFormatter(i, series.Name, value)
+				if style != nil {
+					fontStyle = mergeFontStyles(style.FontStyle, labelStyle)
+				}
+			} else if opt.Label.ValueFormatter != nil {
+				text = opt.Label.ValueFormatter(share)
+			} else {
+				text = FormatValueHumanize(share, 0, false) + "%"
+			}
+			if fontStyle.FontColor.IsZero() {
+				if isLightColor(color) {
+					fontStyle.FontColor = defaultLightFontColor
+				} else {
+					fontStyle.FontColor = defaultDarkFontColor
+				}
+			}
+			textBox := seriesPainter.MeasureText(text, 0, fontStyle)
+			if text == "" || textBox.Width() > x1-x0-4 || textBox.Height() > y1-y0-2 {
+				continue // label does not fit within the segment
+			}
+			seriesPainter.Text(text, (x0+x1-textBox.Width())/2, (y0+y1+textBox.Height())/2, 0, fontStyle)
+		}
+	}
+
+	// draw the percentage axes over the columns
+	axisColor := opt.Theme.GetXAxisStrokeColor()
+	seriesPainter.LineStroke([]Point{{X: left, Y: top}, {X: left, Y: bottom}, {X: right, Y: bottom}}, axisColor, 1)
+	for i, label := range percentLabels {
+		ratio := float64(i) / marimekkoAxisIntervals
+		y := bottom - int(math.Round(ratio*float64(bottom-top)))
+		x := left + int(math.Round(ratio*float64(right-left)))
+		seriesPainter.LineStroke([]Point{{X: left - 4, Y: y}, {X: left, Y: y}}, axisColor, 1)
+		seriesPainter.LineStroke([]Point{{X: x, Y: bottom}, {X: x, Y: bottom + 4}}, axisColor, 1)
+		textBox := seriesPainter.MeasureText(label, 0, axisStyle)
+		seriesPainter.Text(label, left-textBox.Width()-labelMargin, y+textBox.Height()/2, 0, axisStyle)
+		seriesPainter.Text(label, x-textBox.Width()/2, bottom+labelMargin+textBox.Height(), 0, axisStyle)
+	}
+	return p.box, nil
+}
+
+func (m *marimekkoChart) Render() (Box, error) {
+	p := m.p
+	opt := m.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+
+	names := make([]string, len(opt.SeriesList))
+	for i, series := range opt.SeriesList {
+		names[i] = series.Name
+	}
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeMarimekko, seriesNames: names},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title:  opt.Title,
+		legend: &opt.Legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return m.renderChart(renderResult)
+}
@@ -0,0 +1,178 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicMarimekkoChartOption() MarimekkoChartOption {
+	opt := NewMarimekkoChartOptionWithData([][]float64{
+		{420, 210, 150, 60},
+		{300, 260, 90, 20},
+		{180, 120, 160, 30},
+		{100, 90, 40, 15},
+	})
+	for i, name := range []string{"Acme", "Globex", "Initech", "Other"} {
+		opt.SeriesList[i].Name = name
+	}
+	opt.Categories = []string{"North America", "Europe", "Asia", "LATAM"}
+	return opt
+}
+
+func TestNewMarimekkoChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewMarimekkoChartOptionWithData([][]float64{{1, 2}, {3, 4}})
+
+	require.Len(t, opt.SeriesList, 2)
+	assert.Equal(t, []float64{3, 4}, opt.SeriesList[1].Values)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.Theme)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.MarimekkoChart(opt))
+}
+
+func TestMarimekkoTotals(t *testing.T) {
+	t.Parallel()
+
+	totals, total, err := marimekkoTotals([]MarimekkoSeries{
+		{Values: []float64{1, 2, 3}},
+		{Values: []float64{4, GetNullValue()}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{5, 2, 3}, totals)
+	assert.InDelta(t, 10.0, total, 0)
+
+	_, _, err = marimekkoTotals([]MarimekkoSeries{{}, {Values: []float64{1, -1}}})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "unsupported negative value at series index 1")
+}
+
+func TestMarimekkoChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() MarimekkoChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBasicMarimekkoChartOption,
+		},
+		{
+			name: "title_dark",
+			makeOptions: func() MarimekkoChartOption {
+				opt := makeBasicMarimekkoChartOption()
+				opt.Theme = GetTheme(ThemeDark)
+				opt.Title.Text = "Market Share"
+				opt.Legend.Offset = OffsetRight
+				return opt
+			},
+		},
+		{
+			name: "label_formatter",
+			makeOptions: func() MarimekkoChartOption {
+				opt := makeBasicMarimekkoChartOption()
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					return name + " " + FormatValueHumanize(val, 0, false), nil
+				}
+				opt.AxisFontStyle = FontStyle{FontSize: 10, FontColor: ColorBlue}
+				return opt
+			},
+		},
+		{
+			name: "no_labels_null_values",
+			makeOptions: func() MarimekkoChartOption {
+				opt := makeBasicMarimekkoChartOption()
+				opt.Label.Show = Ptr(false)
+				opt.Categories = nil
+				opt.SeriesList[0].Values[3] = GetNullValue()
+				opt.SeriesList[1].Values[1] = 0
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() MarimekkoChartOption {
+				opt := makeBasicMarimekkoChartOption()
+				opt.SeriesList = nil
+				return opt
+			},
+		},
+		{
+			name: "label_formatter_category_index",
+			makeOptions: func() MarimekkoChartOption {
+				opt := makeBasicMarimekkoChartOption()
+				categories := opt.Categories
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					if index == 0 {
+						return categories[index], nil
+					}
+					return name, nil
+				}
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.MarimekkoChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestMarimekkoChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		makeOptions      func() MarimekkoChartOption
+		errorMsgContains string
+	}{
+		{
+			name: "negative_value",
+			makeOptions: func() MarimekkoChartOption {
+				opt := makeBasicMarimekkoChartOption()
+				opt.SeriesList[2].Values[1] = -5
+				return opt
+			},
+			errorMsgContains: "unsupported negative value at series index 2",
+		},
+		{
+			name: "insufficient_space",
+			makeOptions: func() MarimekkoChartOption {
+				opt := makeBasicMarimekkoChartOption()
+				opt.Padding = NewBoxEqual(190)
+				return opt
+			},
+			errorMsgContains: "insufficient space for Marimekko chart",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+
+			err := p.MarimekkoChart(tt.makeOptions())
+			require.Error(t, err)
+			require.ErrorContains(t, err, tt.errorMsgContains)
+		})
+	}
+}
@@ -23,6 +23,14 @@ func (m *SeriesMarkLine) AddGlobalLines(markTypes ...string) {
 	m.Lines = appendMarks(m.Lines, true, markTypes)
 }
 
+// AddValueLines adds mark lines at fixed values for the series. The values are not considered when
+// calculating the axis range.
+func (m *SeriesMarkLine) AddValueLines(values ...float64) {
+	for _, v := range values {
+		m.Lines = append(m.Lines, SeriesMark{Type: SeriesMarkTypeValue, Value: v})
+	}
+}
+
 // NewMarkLine returns a mark line for the provided types. Set on a specific Series instance.
 func NewMarkLine(markLineTypes ...string) SeriesMarkLine {
 	return SeriesMarkLine{
@@ -81,7 +89,7 @@ func (m *markLinePainter) Render() (Box, error) {
 			FontSize:  defaultLabelFontSize,
 		}
 		for _, markLine := range opt.marklines {
-			value := resolveSeriesMarkLineValue(markLine.Type, summary)
+			value := resolveSeriesMarkLineValue(markLine, summary)
 			text := opt.valueFormatter(value)
 			textBox := painter.MeasureText(text, 0, fontStyle)
 			m.renderOne(opt, text, textBox, value, painter, fontStyle)
@@ -121,8 +129,10 @@ func (m *markLinePainter) renderOne(opt markLineRenderOption, text string, textB
 	painter.Text(text, painter.Width(), y+(textBox.Height()>>1)-2, 0, fontStyle)
 }
 
-func resolveSeriesMarkLineValue(markType string, summary PopulationSummary) float64 {
-	switch markType {
+func resolveSeriesMarkLineValue(mark SeriesMark, summary PopulationSummary) float64 {
+	switch mark.Type {
+	case SeriesMarkTypeValue:
+		return mark.Value
 	case SeriesMarkTypeMax:
 		return summary.Max
 	case SeriesMarkTypeMin:
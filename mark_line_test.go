@@ -47,6 +47,25 @@ func TestMarkLine(t *testing.T) {
 				return p.Bytes()
 			},
 		},
+		{ // fixed value mark line
+			render: func(p *Painter) ([]byte, error) {
+				markLine := newMarkLinePainter(p)
+				var seriesMarkLine SeriesMarkLine
+				seriesMarkLine.AddValueLines(4.5)
+				markLine.add(markLineRenderOption{
+					fillColor:    ColorBlack,
+					fontColor:    ColorBlack,
+					strokeColor:  ColorBlack,
+					seriesValues: []float64{1, 2, 3},
+					marklines:    seriesMarkLine.Lines,
+					axisRange:    newTestRange(p.Height(), 6, 0.0, 5.0, 0.0, 0.0),
+				})
+				if _, err := markLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
 	}
 
 	for i, tt := range tests {
@@ -100,6 +100,8 @@ func (m *markPointPainter) Render() (Box, error) {
 			var index int
 			var value float64
 			switch markPointData.Type {
+			case SeriesMarkTypeValue:
+				continue // fixed values are only supported for mark lines
 			case SeriesMarkTypeMax:
 				index = summary.MaxIndex
 				value = summary.Max
@@ -344,6 +344,39 @@ func (p *Painter) drawStraightPath(points []Point, dotForSinglePoint bool) {
 	}
 }
 
+// StepLineStroke draws a piecewise constant line through the given points, with the step position specified as
+// LineStepBefore, LineStepAfter, or LineStepMiddle. Points with values of math.MaxInt32 are skipped, resulting in a gap.
+func (p *Painter) StepLineStroke(points []Point, step string, strokeColor Color, strokeWidth float64) {
+	p.LineStroke(stepPathPoints(points, step), strokeColor, strokeWidth)
+}
+
+// stepPathPoints returns the points with corner points inserted so straight lines between them form steps.
+// Break markers (math.MaxInt32) are preserved, an unknown step returns the points unchanged.
+func stepPathPoints(points []Point, step string) []Point {
+	switch step {
+	case LineStepBefore, LineStepAfter, LineStepMiddle:
+	default:
+		return points
+	}
+	result := make([]Point, 0, len(points)*3)
+	for i, pt := range points {
+		if i > 0 && pt.Y != math.MaxInt32 && points[i-1].Y != math.MaxInt32 {
+			prev := points[i-1]
+			switch step {
+			case LineStepBefore:
+				result = append(result, Point{X: prev.X, Y: pt.Y})
+			case LineStepAfter:
+				result = append(result, Point{X: pt.X, Y: prev.Y})
+			case LineStepMiddle:
+				midX := (prev.X + pt.X) / 2
+				result = append(result, Point{X: midX, Y: prev.Y}, Point{X: midX, Y: pt.Y})
+			}
+		}
+		result = append(result, pt)
+	}
+	return result
+}
+
 // SmoothLineStroke draws a smooth curve through the given points using Quadratic Bézier segments and a
 // tension parameter in [0..1] with 0 providing straight lines through the data points and 1 providing a smoother line.
 // Because tension smooths out the line, the line no longer hits the provided points exactly. The more variable
@@ -789,6 +822,37 @@ func (p *Painter) TextFit(body string, x, y, width int, fontStyle FontStyle, tex
 	return output
 }
 
+// measureTextFit returns the box TextFit would fill when drawing the text constrained to the given width.
+func (p *Painter) measureTextFit(body string, width int, fontStyle FontStyle) Box {
+	if fontStyle.Font == nil {
+		fontStyle.Font = getPreferredFont(p.font)
+	}
+	style := chartdraw.Style{
+		FontStyle: fontStyle,
+		TextWrap:  chartdraw.TextWrapWord,
+	}
+	r := p.render
+	defer r.ResetStyle()
+	r.SetFont(fontStyle.Font)
+	r.SetFontSize(fontStyle.FontSize)
+
+	lines := chartdraw.Text.WrapFit(r, body, width, style)
+	var output Box
+	for index, line := range lines {
+		if line == "" {
+			continue
+		}
+		lineBox := r.MeasureText(line)
+		output.Right = max(lineBox.Right, output.Right)
+		output.Bottom += lineBox.Height()
+		if index < len(lines)-1 {
+			output.Bottom += style.GetTextLineSpacing()
+		}
+	}
+	output.IsSet = true
+	return output
+}
+
 // isTick determines whether the given index is a "tick" mark out of numTicks.
 func isTick(totalRange int, numTicks int, index int) bool {
 	if numTicks >= totalRange {
@@ -1174,6 +1238,120 @@ func (p *Painter) ViolinChart(opt ViolinChartOption) error {
 	return err
 }
 
+// BoxPlotChart renders a box plot chart with the provided configuration to the painter.
+func (p *Painter) BoxPlotChart(opt BoxPlotChartOption) error {
+	_, err := newBoxPlotChart(p, opt).Render()
+	return err
+}
+
+// HistogramChart renders a histogram with the provided configuration to the painter.
+func (p *Painter) HistogramChart(opt HistogramOption) error {
+	_, err := newHistogramChart(p, opt).Render()
+	return err
+}
+
+// WaterfallChart renders a waterfall chart with the provided configuration to the painter.
+func (p *Painter) WaterfallChart(opt WaterfallChartOption) error {
+	_, err := newWaterfallChart(p, opt).Render()
+	return err
+}
+
+// TreemapChart renders a treemap chart with the provided configuration to the painter.
+func (p *Painter) TreemapChart(opt TreemapChartOption) error {
+	_, err := newTreemapChart(p, opt).Render()
+	return err
+}
+
+// SunburstChart renders a sunburst chart with the provided configuration to the painter.
+func (p *Painter) SunburstChart(opt SunburstChartOption) error {
+	_, err := newSunburstChart(p, opt).Render()
+	return err
+}
+
+// SankeyChart renders a sankey chart with the provided configuration to the painter.
+func (p *Painter) SankeyChart(opt SankeyChartOption) error {
+	_, err := newSankeyChart(p, opt).Render()
+	return err
+}
+
+// GaugeChart renders a gauge chart with the provided configuration to the painter.
+func (p *Painter) GaugeChart(opt GaugeChartOption) error {
+	_, err := newGaugeChart(p, opt).Render()
+	return err
+}
+
+// GanttChart renders a gantt chart with the provided configuration to the painter.
+func (p *Painter) GanttChart(opt GanttChartOption) error {
+	_, err := newGanttChart(p, opt).Render()
+	return err
+}
+
+// CalendarHeatMapChart renders a calendar heat map with the provided configuration to the painter.
+func (p *Painter) CalendarHeatMapChart(opt CalendarHeatMapOption) error {
+	_, err := newCalendarHeatMapChart(p, opt).Render()
+	return err
+}
+
+// ParallelChart renders a parallel coordinates chart with the provided configuration to the painter.
+func (p *Painter) ParallelChart(opt ParallelChartOption) error {
+	_, err := newParallelChart(p, opt).Render()
+	return err
+}
+
+// BulletChart renders a bullet chart with the provided configuration to the painter.
+func (p *Painter) BulletChart(opt BulletChartOption) error {
+	_, err := newBulletChart(p, opt).Render()
+	return err
+}
+
+// SparklineChart renders a sparkline with the provided configuration to the painter.
+func (p *Painter) SparklineChart(opt SparklineOption) error {
+	_, err := newSparklineChart(p, opt).Render()
+	return err
+}
+
+// DensityChart renders a 2D density (hexbin) chart with the provided configuration to the painter.
+func (p *Painter) DensityChart(opt DensityChartOption) error {
+	_, err := newDensityChart(p, opt).Render()
+	return err
+}
+
+// RadialBarChart renders a radial bar chart with the provided configuration to the painter.
+func (p *Painter) RadialBarChart(opt RadialBarChartOption) error {
+	_, err := newRadialBarChart(p, opt).Render()
+	return err
+}
+
+// DumbbellChart renders a dumbbell chart with the provided configuration to the painter.
+func (p *Painter) DumbbellChart(opt DumbbellChartOption) error {
+	_, err := newDumbbellChart(p, opt).Render()
+	return err
+}
+
+// SlopeChart renders a slope chart with the provided configuration to the painter.
+func (p *Painter) SlopeChart(opt SlopeChartOption) error {
+	_, err := newSlopeChart(p, opt).Render()
+	return err
+}
+
+// RidgelineChart renders a ridgeline chart with the provided configuration to the painter.
+func (p *Painter) RidgelineChart(opt RidgelineChartOption) error {
+	_, err := newRidgelineChart(p, opt).Render()
+	return err
+}
+
+// MarimekkoChart renders a Marimekko chart with the provided configuration to the painter.
+func (p *Painter) MarimekkoChart(opt MarimekkoChartOption) error {
+	_, err := newMarimekkoChart(p, opt).Render()
+	return err
+}
+
+// DivergingBarChart renders a diverging bar chart with the provided configuration to the painter.
+func (p *Painter) DivergingBarChart(opt DivergingBarChartOption) error {
+	_, err := newDivergingBarChart(p, opt).Render()
+	return err
+}
+
 // LayoutBuilderGrid is returned by Painter.LayoutByGrid() and provides methods
 // for building grid-based layouts with cell spanning support.
 type LayoutBuilderGrid interface {
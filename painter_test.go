@@ -995,6 +995,9 @@ func TestPainterTextFit(t *testing.T) {
 	box = p.TextFit(text, 0, 100, 200, fontStyle)
 	assert.Equal(t, Box{Right: 84, Bottom: 16, IsSet: true}, box)
 
+	assert.Equal(t, Box{Right: 45, Bottom: 37, IsSet: true}, p.measureTextFit(text, 80, fontStyle))
+	assert.Equal(t, Box{Right: 84, Bottom: 16, IsSet: true}, p.measureTextFit(text, 200, fontStyle))
+
 	buf, err := p.Bytes()
 	require.NoError(t, err)
 	assertTestdataSVG(t, buf)
@@ -1229,3 +1232,40 @@ func TestSmoothDashedLineStroke(t *testing.T) {
 		})
 	}
 }
+
+func TestStepPathPoints(t *testing.T) {
+	t.Parallel()
+
+	points := []Point{{X: 0, Y: 10}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32}, {X: 30, Y: 5}, {X: 40, Y: 15}}
+
+	assert.Equal(t, []Point{
+		{X: 0, Y: 10}, {X: 0, Y: 20}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32},
+		{X: 30, Y: 5}, {X: 30, Y: 15}, {X: 40, Y: 15},
+	}, stepPathPoints(points, LineStepBefore))
+	assert.Equal(t, []Point{
+		{X: 0, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32},
+		{X: 30, Y: 5}, {X: 40, Y: 5}, {X: 40, Y: 15},
+	}, stepPathPoints(points, LineStepAfter))
+	assert.Equal(t, []Point{
+		{X: 0, Y: 10}, {X: 5, Y: 10}, {X: 5, Y: 20}, {X: 10, Y: 20}, {X: 20, Y: math.MaxInt32},
+		{X: 30, Y: 5}, {X: 35, Y: 5}, {X: 35, Y: 15}, {X: 40, Y: 15},
+	}, stepPathPoints(points, LineStepMiddle))
+	assert.Equal(t, points, stepPathPoints(points, ""))
+	assert.Empty(t, stepPathPoints(nil, LineStepAfter))
+}
+
+func TestStepLineStroke(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		Width:        400,
+		Height:       300,
+		OutputFormat: ChartOutputSVG,
+	})
+	points := []Point{{X: 20, Y: 200}, {X: 120, Y: 100}, {X: 220, Y: 150}, {X: 320, Y: math.MaxInt32}, {X: 380, Y: 50}}
+	p.StepLineStroke(points, LineStepAfter, ColorBlue, 2)
+	p.StepLineStroke(points, LineStepMiddle, ColorRed, 1)
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, buf)
+}
@@ -0,0 +1,319 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ParallelAxis defines a dimension of a parallel coordinates chart, rendered as a vertical value axis.
+type ParallelAxis struct {
+	// Name specifies the name rendered above the axis.
+	Name string
+	// Min sets the minimum value of the axis. If nil, calculated from the data.
+	Min *float64
+	// Max sets the maximum value of the axis. If nil, calculated from the data.
+	Max *float64
+	// Inverse when true places the minimum value at the top of the axis.
+	Inverse bool
+	// ValueFormatter defines how the axis labels are rendered to strings.
+	ValueFormatter ValueFormatter
+	// FontStyle specifies the font for the axis name and labels.
+	FontStyle FontStyle
+}
+
+// ParallelSeries is a group of records rendered with the same color. Each record provides a value for each axis.
+type ParallelSeries struct {
+	// Name specifies a name for the series.
+	Name string
+	// Values provides the records, with the inner slice providing the value for each axis in order. Null or missing
+	// values leave a gap in the record line.
+	Values [][]float64
+}
+
+// ParallelChartOption defines the options for rendering a parallel coordinates chart. Render the chart using
+// Painter.ParallelChart.
+type ParallelChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the series legend. The legend is hidden by default when ColorAxisIndex is set.
+	Legend LegendOption
+	// Axes provides the dimensions of the chart, at least two are required.
+	Axes []ParallelAxis
+	// SeriesList provides the records for the chart.
+	SeriesList []ParallelSeries
+	// LineStrokeWidth is the width of each record line. Default is 1.5.
+	LineStrokeWidth float64
+	// LineOpacity is the opacity/alpha (0-255) of each record line. Default is 180.
+	LineOpacity uint8
+	// ColorAxisIndex when set colors each record by a gradient of its value on the indexed axis, rather than by
+	// series.
+	ColorAxisIndex *int
+	// GradientStartColor is the record color for the minimum value of the color axis. Default is the first theme
+	// series color.
+	GradientStartColor Color
+	// GradientEndColor is the record color for the maximum value of the color axis. Default is the fourth theme
+	// series color.
+	GradientEndColor Color
+	// LabelCount sets the number of labels on each axis. Default is 5.
+	LabelCount int
+}
+
+type parallelChart struct {
+	p   *Painter
+	opt *ParallelChartOption
+}
+
+// newParallelChart returns a parallel coordinates chart renderer.
+func newParallelChart(p *Painter, opt ParallelChartOption) *parallelChart {
+	return &parallelChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewParallelChartOptionWithData returns an initialized ParallelChartOption with an axis for each name and a single
+// series containing the provided records.
+func NewParallelChartOptionWithData(names []string, records [][]float64) ParallelChartOption {
+	axes := make([]ParallelAxis, len(names))
+	for i, name := range names {
+		axes[i] = ParallelAxis{Name: name}
+	}
+	return ParallelChartOption{
+		Axes:       axes,
+		SeriesList: []ParallelSeries{{Values: records}},
+		Padding:    defaultPadding,
+		Theme:      GetDefaultTheme(),
+	}
+}
+
+// parallelAxisRange is the resolved value range of an axis.
+type parallelAxisRange struct {
+	min, max float64
+	labels   []float64
+}
+
+// ratio returns the position of the value from the bottom (0) to the top (1) of the axis.
+func (r parallelAxisRange) ratio(value float64, inverse bool) float64 {
+	ratio := (value - r.min) / (r.max - r.min)
+	if inverse {
+		return 1 - ratio
+	}
+	return ratio
+}
+
+// newParallelAxisRange resolves the axis range, rounding to nice label intervals for bounds derived from the data.
+func newParallelAxisRange(axis ParallelAxis, index int, seriesList []ParallelSeries, labelCount int) parallelAxisRange {
+	minVal, maxVal := math.MaxFloat64, -math.MaxFloat64
+	for _, series := range seriesList {
+		for _, record := range series.Values {
+			if index < len(record) && isValidExtent(record[index]) {
+				minVal = min(minVal, record[index])
+				maxVal = max(maxVal, record[index])
+			}
+		}
+	}
+	if minVal > maxVal {
+		minVal, maxVal = 0, 1 // no data
+	}
+	if axis.Min != nil {
+		minVal = *axis.Min
+	}
+	if axis.Max != nil {
+		maxVal = *axis.Max
+	}
+	if maxVal <= minVal {
+		maxVal = minVal + zeroSpanWidth(minVal)
+	}
+
+	divideCount := float64(labelCount - 1)
+	interval := (maxVal - minVal) / divideCount
+	count := labelCount
+	if axis.Min == nil || axis.Max == nil {
+		interval = niceNum(interval)
+		if axis.Min == nil {
+			minVal = math.Floor(minVal/interval) * interval
+		}
+		if axis.Max == nil {
+			maxVal = math.Ceil(maxVal/interval) * interval
+		}
+		if axis.Min != nil || axis.Max != nil {
+			interval = (maxVal - minVal) / divideCount // keep evenly spaced labels with the configured bound
+		} else {
+			// rounding out to the nice interval adds at most one label at each end
+			count = min(int(math.Round((maxVal-minVal)/interval))+1, labelCount+2)
+		}
+	}
+	r := parallelAxisRange{min: minVal, max: maxVal}
+	for i := 0; i < count; i++ {
+		r.labels = append(r.labels, minVal+float64(i)*interval)
+	}
+	return r
+}
+
+// parallelGradientColor returns the color at the ratio between the start and end colors.
+func parallelGradientColor(start, end Color, ratio float64) Color {
+	ratio = min(max(ratio, 0), 1)
+	lerp := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*ratio))
+	}
+	return Color{R: lerp(start.R, end.R), G: lerp(start.G, end.G), B: lerp(start.B, end.B), A: lerp(start.A, end.A)}
+}
+
+func (c *parallelChart) renderChart(result *defaultRenderResult) (Box, error) {
+	opt := c.opt
+	axisCount := len(opt.Axes)
+	if axisCount < 2 {
+		return BoxZero, errors.New("parallel chart requires at least 2 axes")
+	} else if opt.ColorAxisIndex != nil && (*opt.ColorAxisIndex < 0 || *opt.ColorAxisIndex >= axisCount) {
+		return BoxZero, fmt.Errorf("parallel ColorAxisIndex %d is out of range", *opt.ColorAxisIndex)
+	}
+	var recordCount int
+	for _, series := range opt.SeriesList {
+		recordCount += len(series.Values)
+	}
+	if recordCount == 0 {
+		result.renderNoData(opt.Theme)
+		return c.p.box, nil
+	}
+
+	p := result.seriesPainter
+	labelCount := opt.LabelCount
+	if labelCount < 2 {
+		labelCount = 5
+	}
+	ranges := make([]parallelAxisRange, axisCount)
+	labels := make([][]string, axisCount)
+	fontStyles := make([]FontStyle, axisCount)
+	var nameHeight int
+	for i, axis := range opt.Axes {
+		ranges[i] = newParallelAxisRange(axis, i, opt.SeriesList, labelCount)
+		formatter := getPreferredValueFormatter(axis.ValueFormatter)
+		labels[i] = make([]string, len(ranges[i].labels))
+		for j, v := range ranges[i].labels {
+			labels[i][j] = formatter(v)
+		}
+		fontStyles[i] = fillFontStyleDefaults(axis.FontStyle, defaultFontSize, opt.Theme.GetYAxisTextColor(), p.font)
+		nameHeight = max(nameHeight, p.MeasureText(axis.Name, 0, fontStyles[i]).Height())
+	}
+
+	// reserve space for the first axis labels and half the width of the outer axis names
+	const labelMargin = 6
+	firstLabelWidth, labelHeight := p.measureTextMaxWidthHeight(labels[0], 0, fontStyles[0])
+	left := max(firstLabelWidth+labelMargin, p.MeasureText(opt.Axes[0].Name, 0, fontStyles[0]).Width()/2)
+	right := p.Width() - p.MeasureText(opt.Axes[axisCount-1].Name, 0, fontStyles[axisCount-1]).Width()/2
+	top := nameHeight + labelMargin + labelHeight/2
+	bottom := p.Height() - labelHeight/2
+	if right-left < axisCount || bottom-top < 2 {
+		return BoxZero, errors.New("insufficient space for parallel chart")
+	}
+	axisX := autoDivide(right-left, axisCount-1)
+	for i := range axisX {
+		axisX[i] += left
+	}
+	valueY := func(axisIndex int, value float64) int {
+		ratio := ranges[axisIndex].ratio(value, opt.Axes[axisIndex].Inverse)
+		return bottom - int(math.Round(ratio*float64(bottom-top)))
+	}
+
+	// draw the records beneath the axes
+	strokeWidth := opt.LineStrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1.5
+	}
+	var opacity uint8 = 180
+	if opt.LineOpacity > 0 {
+		opacity = opt.LineOpacity
+	}
+	gradientStart, gradientEnd := opt.GradientStartColor, opt.GradientEndColor
+	if gradientStart.IsZero() {
+		gradientStart = opt.Theme.GetSeriesColor(0)
+	}
+	if gradientEnd.IsZero() {
+		gradientEnd = opt.Theme.GetSeriesColor(3)
+	}
+	points := make([]Point, axisCount)
+	for seriesIndex, series := range opt.SeriesList {
+		seriesColor := opt.Theme.GetSeriesColor(seriesIndex)
+		for _, record := range series.Values {
+			for i := range points {
+				points[i] = Point{X: axisX[i], Y: math.MaxInt32}
+				if i < len(record) && isValidExtent(record[i]) {
+					points[i].Y = valueY(i, record[i])
+				}
+			}
+			color := seriesColor
+			if opt.ColorAxisIndex != nil {
+				colorIndex := *opt.ColorAxisIndex
+				if colorIndex >= len(record) || !isValidExtent(record[colorIndex]) {
+					color = opt.Theme.GetAxisSplitLineColor()
+				} else {
+					color = parallelGradientColor(gradientStart, gradientEnd,
+						ranges[colorIndex].ratio(record[colorIndex], false))
+				}
+			}
+			p.LineStroke(points, color.WithAlpha(opacity), strokeWidth)
+		}
+	}
+
+	// draw each axis with its name, ticks, and labels
+	axisColor := opt.Theme.GetYAxisStrokeColor()
+	for i, axis := range opt.Axes {
+		x := axisX[i]
+		p.LineStroke([]Point{{X: x, Y: top}, {X: x, Y: bottom}}, axisColor, 1)
+		if axis.Name != "" {
+			nameBox := p.MeasureText(axis.Name, 0, fontStyles[i])
+			p.Text(axis.Name, x-nameBox.Width()/2, nameHeight, 0, fontStyles[i])
+		}
+		for j, v := range ranges[i].labels {
+			y := valueY(i, v)
+			p.LineStroke([]Point{{X: x - 3, Y: y}, {X: x, Y: y}}, axisColor, 1)
+			textBox := p.MeasureText(labels[i][j], 0, fontStyles[i])
+			p.Text(labels[i][j], x-textBox.Width()-labelMargin, y+textBox.Height()/2, 0, fontStyles[i])
+		}
+	}
+	return c.p.box, nil
+}
+
+func (c *parallelChart) Render() (Box, error) {
+	p := c.p
+	opt := c.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolDot
+	}
+	legend := opt.Legend
+	if legend.Show == nil && opt.ColorAxisIndex != nil {
+		legend.Show = Ptr(false) // colors represent the gradient rather than the series
+	}
+
+	names := make([]string, len(opt.SeriesList))
+	for i, series := range opt.SeriesList {
+		names[i] = series.Name
+	}
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeParallel, seriesNames: names},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title:  opt.Title,
+		legend: &legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return c.renderChart(renderResult)
+}
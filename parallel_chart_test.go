@@ -0,0 +1,228 @@
+package charts
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeParallelBenchmarkOption() ParallelChartOption {
+	opt := NewParallelChartOptionWithData([]string{"Throughput", "Latency", "Memory", "CPU", "Errors"}, nil)
+	opt.SeriesList = []ParallelSeries{
+		{
+			Name: "v1",
+			Values: [][]float64{
+				{1200, 45, 512, 62, 3},
+				{1350, 41, 540, 70, 2},
+				{980, 58, 498, 55, 6},
+			},
+		},
+		{
+			Name: "v2",
+			Values: [][]float64{
+				{1800, 30, 720, 81, 1},
+				{1720, 33, 690, 77, 0},
+				{1650, 36, 705, 74, 2},
+			},
+		},
+	}
+	return opt
+}
+
+func TestNewParallelChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewParallelChartOptionWithData([]string{"A", "B"}, [][]float64{{1, 2}, {3, 4}})
+
+	require.Len(t, opt.Axes, 2)
+	assert.Equal(t, "B", opt.Axes[1].Name)
+	require.Len(t, opt.SeriesList, 1)
+	assert.Len(t, opt.SeriesList[0].Values, 2)
+	assert.Equal(t, defaultPadding, opt.Padding)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.ParallelChart(opt))
+}
+
+func TestNewParallelAxisRange(t *testing.T) {
+	t.Parallel()
+
+	seriesList := []ParallelSeries{{Values: [][]float64{{3, 12}, {47, GetNullValue()}, {21}}}}
+
+	t.Run("auto", func(t *testing.T) {
+		r := newParallelAxisRange(ParallelAxis{}, 0, seriesList, 5)
+		assert.InDelta(t, 0.0, r.min, 0)
+		assert.InDelta(t, 60.0, r.max, 0)
+		assert.Equal(t, []float64{0, 20, 40, 60}, r.labels)
+	})
+	t.Run("configured", func(t *testing.T) {
+		r := newParallelAxisRange(ParallelAxis{Min: Ptr(0.0), Max: Ptr(100.0)}, 0, seriesList, 3)
+		assert.Equal(t, []float64{0, 50, 100}, r.labels)
+	})
+	t.Run("configured_min", func(t *testing.T) {
+		r := newParallelAxisRange(ParallelAxis{Min: Ptr(10.0)}, 1, seriesList, 5)
+		assert.InDelta(t, 10.0, r.min, 0)
+		assert.InDelta(t, 12.0, r.max, 0)
+		assert.Len(t, r.labels, 5)
+	})
+	t.Run("no_data", func(t *testing.T) {
+		r := newParallelAxisRange(ParallelAxis{}, 3, seriesList, 5)
+		assert.InDelta(t, 0.0, r.min, 0)
+		assert.InDelta(t, 1.0, r.max, 0)
+	})
+	t.Run("constant_large", func(t *testing.T) {
+		r := newParallelAxisRange(ParallelAxis{}, 0, []ParallelSeries{{Values: [][]float64{{1e20}, {1e20}}}}, 5)
+		assert.Greater(t, r.max, r.min)
+		assert.NotEmpty(t, r.labels)
+	})
+	t.Run("adjacent_large", func(t *testing.T) {
+		r := newParallelAxisRange(ParallelAxis{}, 0, []ParallelSeries{{Values: [][]float64{
+			{1e20}, {math.Nextafter(1e20, math.Inf(1))},
+		}}}, 5)
+		assert.NotEmpty(t, r.labels)
+		assert.LessOrEqual(t, len(r.labels), 7)
+	})
+	t.Run("inverse_ratio", func(t *testing.T) {
+		r := parallelAxisRange{min: 0, max: 10}
+		assert.InDelta(t, 0.25, r.ratio(2.5, false), 0)
+		assert.InDelta(t, 0.75, r.ratio(2.5, true), 0)
+	})
+}
+
+func TestParallelGradientColor(t *testing.T) {
+	t.Parallel()
+
+	start := Color{R: 0, G: 100, B: 200, A: 255}
+	end := Color{R: 200, G: 100, B: 0, A: 255}
+	assert.Equal(t, start, parallelGradientColor(start, end, -1))
+	assert.Equal(t, Color{R: 100, G: 100, B: 100, A: 255}, parallelGradientColor(start, end, 0.5))
+	assert.Equal(t, end, parallelGradientColor(start, end, 2))
+}
+
+func TestParallelChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() ParallelChartOption
+	}{
+		{
+			name: "basic",
+			makeOptions: func() ParallelChartOption {
+				opt := makeParallelBenchmarkOption()
+				opt.Title.Text = "Benchmarks"
+				return opt
+			},
+		},
+		{
+			name: "axis_options",
+			makeOptions: func() ParallelChartOption {
+				opt := makeParallelBenchmarkOption()
+				opt.Axes[1].Inverse = true
+				opt.Axes[3].Min = Ptr(0.0)
+				opt.Axes[3].Max = Ptr(100.0)
+				opt.Axes[3].ValueFormatter = func(f float64) string {
+					return strconv.Itoa(int(f)) + "%"
+				}
+				opt.Axes[4].FontStyle = FontStyle{FontSize: 14, FontColor: ColorRed}
+				opt.LabelCount = 3
+				opt.Legend.Offset = OffsetRight
+				return opt
+			},
+		},
+		{
+			name: "gradient",
+			makeOptions: func() ParallelChartOption {
+				opt := makeParallelBenchmarkOption()
+				opt.ColorAxisIndex = Ptr(0)
+				opt.LineStrokeWidth = 3
+				opt.LineOpacity = 255
+				return opt
+			},
+		},
+		{
+			name: "gradient_custom_colors_dark",
+			makeOptions: func() ParallelChartOption {
+				opt := makeParallelBenchmarkOption()
+				opt.Theme = GetTheme(ThemeDark)
+				opt.ColorAxisIndex = Ptr(1)
+				opt.GradientStartColor = ColorGreen
+				opt.GradientEndColor = ColorRed
+				opt.SeriesList[0].Values = append(opt.SeriesList[0].Values, []float64{1100, GetNullValue(), 600})
+				return opt
+			},
+		},
+		{
+			name: "null_values",
+			makeOptions: func() ParallelChartOption {
+				opt := makeParallelBenchmarkOption()
+				opt.SeriesList[0].Values[0][2] = GetNullValue()
+				opt.SeriesList[1].Values[1] = opt.SeriesList[1].Values[1][:3]
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() ParallelChartOption {
+				return NewParallelChartOptionWithData([]string{"A", "B"}, nil)
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.ParallelChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestParallelChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		makeOption func() ParallelChartOption
+		errorMsg   string
+	}{
+		{
+			name: "single_axis",
+			makeOption: func() ParallelChartOption {
+				return NewParallelChartOptionWithData([]string{"A"}, [][]float64{{1}})
+			},
+			errorMsg: "at least 2 axes",
+		},
+		{
+			name: "color_axis_range",
+			makeOption: func() ParallelChartOption {
+				opt := makeParallelBenchmarkOption()
+				opt.ColorAxisIndex = Ptr(5)
+				return opt
+			},
+			errorMsg: "ColorAxisIndex 5 is out of range",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			err := p.ParallelChart(tt.makeOption())
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
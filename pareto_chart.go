@@ -0,0 +1,147 @@
+package charts
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+const (
+	defaultParetoThreshold = 80
+	// paretoAxisIntervals is the number of label intervals on both y-axes, keeping the percentage axis at 20% steps.
+	paretoAxisIntervals = 5
+)
+
+// ParetoOption customizes the chart produced by NewParetoChartOption.
+type ParetoOption struct {
+	// BarName specifies the series name for the value bars. Default is "Value".
+	BarName string
+	// LineName specifies the series name for the cumulative percentage line. Default is "Cumulative %".
+	LineName string
+	// ShowThreshold controls the cumulative percentage reference line. Set to *false to hide.
+	ShowThreshold *bool
+	// Threshold sets the cumulative percentage marked by the reference line. Default is 80.
+	Threshold float64
+}
+
+// paretoValues returns the labels and values sorted by descending value, along with the cumulative percentage of
+// the total at each position. Null values are sorted last and do not contribute to the total.
+func paretoValues(labels []string, values []float64) ([]string, []float64, []float64) {
+	indexes := make([]int, len(values))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		aValid, bValid := isValidExtent(values[a]), isValidExtent(values[b])
+		if aValid != bValid {
+			if aValid {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(values[b], values[a])
+	})
+
+	var total float64
+	for _, v := range values {
+		if isValidExtent(v) {
+			total += v
+		}
+	}
+	sortedLabels := make([]string, len(values))
+	sortedValues := make([]float64, len(values))
+	cumulative := make([]float64, len(values))
+	var sum float64
+	for i, index := range indexes {
+		if index < len(labels) {
+			sortedLabels[i] = labels[index]
+		}
+		sortedValues[i] = values[index]
+		if !isValidExtent(values[index]) {
+			cumulative[i] = GetNullValue()
+			continue
+		}
+		sum += values[index]
+		if total != 0 {
+			cumulative[i] = sum / total * 100
+		}
+	}
+	return sortedLabels, sortedValues, cumulative
+}
+
+// NewParetoChartOption returns a ChartOption for a Pareto chart of the category values. Categories are sorted by
+// descending value and rendered as bars on the primary y-axis, while the running total is rendered as a cumulative
+// percentage line on a secondary 0-100% y-axis, with a reference line at the threshold percentage. Values are
+// expected to be non-negative.
+func NewParetoChartOption(labels []string, values []float64, opts ...ParetoOption) ChartOption {
+	var opt ParetoOption
+	if len(opts) != 0 {
+		opt = opts[0]
+	}
+	if opt.BarName == "" {
+		opt.BarName = "Value"
+	}
+	if opt.LineName == "" {
+		opt.LineName = "Cumulative %"
+	}
+	if opt.Threshold <= 0 {
+		opt.Threshold = defaultParetoThreshold
+	}
+
+	sortedLabels, sortedValues, cumulative := paretoValues(labels, values)
+	percentFormatter := func(f float64) string {
+		return FormatValueHumanize(f, 0, false) + "%"
+	}
+	line := GenericSeries{
+		Type:       ChartTypeLine,
+		Name:       opt.LineName,
+		Values:     cumulative,
+		YAxisIndex: 1,
+	}
+	if !flagIs(false, opt.ShowThreshold) {
+		line.MarkLine.ValueFormatter = percentFormatter
+		if math.Mod(opt.Threshold, 100/paretoAxisIntervals) == 0 {
+			// the threshold falls on a percentage axis label, avoid overlapping text
+			line.MarkLine.ValueFormatter = func(float64) string { return "" }
+		}
+		line.MarkLine.AddValueLines(opt.Threshold)
+	}
+
+	// the value axis max is rounded so both axes share evenly spaced labels at nice intervals
+	var maxValue float64
+	for _, v := range sortedValues {
+		if isValidExtent(v) {
+			maxValue = max(maxValue, v)
+		}
+	}
+	valueAxisMax := niceNum(maxValue/paretoAxisIntervals) * paretoAxisIntervals
+	if valueAxisMax <= 0 {
+		valueAxisMax = paretoAxisIntervals
+	}
+	return ChartOption{
+		SeriesList: GenericSeriesList{
+			{
+				Type:   ChartTypeBar,
+				Name:   opt.BarName,
+				Values: sortedValues,
+			},
+			line,
+		},
+		XAxis: XAxisOption{
+			Labels: sortedLabels,
+		},
+		YAxis: []YAxisOption{
+			{
+				Min:        Ptr(0.0), // bars always grow from zero
+				Max:        Ptr(valueAxisMax),
+				LabelCount: paretoAxisIntervals + 1,
+			},
+			{
+				Min:            Ptr(0.0),
+				Max:            Ptr(100.0),
+				LabelCount:     paretoAxisIntervals + 1,
+				ValueFormatter: percentFormatter,
+			},
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	paretoTestLabels = []string{"Late", "Damaged", "Wrong item", "Missing", "Billing", "Other", "Rude"}
+	paretoTestValues = []float64{48, 22, 120, 9, 35, 4, 16}
+)
+
+func TestParetoValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		labels             []string
+		values             []float64
+		expectedLabels     []string
+		expectedValues     []float64
+		expectedCumulative []float64
+	}{
+		{
+			name:               "sorted_descending",
+			labels:             []string{"a", "b", "c", "d"},
+			values:             []float64{10, 40, 20, 30},
+			expectedLabels:     []string{"b", "d", "c", "a"},
+			expectedValues:     []float64{40, 30, 20, 10},
+			expectedCumulative: []float64{40, 70, 90, 100},
+		},
+		{
+			name:               "null_last",
+			labels:             []string{"a", "b", "c"},
+			values:             []float64{GetNullValue(), 1, 3},
+			expectedLabels:     []string{"c", "b", "a"},
+			expectedValues:     []float64{3, 1, GetNullValue()},
+			expectedCumulative: []float64{75, 100, GetNullValue()},
+		},
+		{
+			name:               "stable_ties_missing_labels",
+			labels:             []string{"a"},
+			values:             []float64{5, 5, 10},
+			expectedLabels:     []string{"", "a", ""},
+			expectedValues:     []float64{10, 5, 5},
+			expectedCumulative: []float64{50, 75, 100},
+		},
+		{
+			name:               "zero_total",
+			labels:             []string{"a", "b"},
+			values:             []float64{0, 0},
+			expectedLabels:     []string{"a", "b"},
+			expectedValues:     []float64{0, 0},
+			expectedCumulative: []float64{0, 0},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			labels, values, cumulative := paretoValues(tt.labels, tt.values)
+			assert.Equal(t, tt.expectedLabels, labels)
+			assert.Equal(t, tt.expectedValues, values)
+			assert.InDeltaSlice(t, tt.expectedCumulative, cumulative, 0.000001)
+		})
+	}
+}
+
+func TestNewParetoChartOption(t *testing.T) {
+	t.Parallel()
+
+	opt := NewParetoChartOption(paretoTestLabels, paretoTestValues)
+	require.Len(t, opt.SeriesList, 2)
+	assert.Equal(t, ChartTypeBar, opt.SeriesList[0].Type)
+	assert.Equal(t, ChartTypeLine, opt.SeriesList[1].Type)
+	assert.Equal(t, 1, opt.SeriesList[1].YAxisIndex)
+	assert.Equal(t, SeriesMarkList{{Type: SeriesMarkTypeValue, Value: 80}}, opt.SeriesList[1].MarkLine.Lines)
+	require.Len(t, opt.YAxis, 2)
+	assert.InDelta(t, 125.0, *opt.YAxis[0].Max, 0)
+	assert.InDelta(t, 100.0, *opt.YAxis[1].Max, 0)
+
+	opt = NewParetoChartOption(paretoTestLabels, paretoTestValues, ParetoOption{ShowThreshold: Ptr(false)})
+	assert.Empty(t, opt.SeriesList[1].MarkLine.Lines)
+}
+
+func TestParetoChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		render func() (*Painter, error)
+	}{
+		{
+			name: "render",
+			render: func() (*Painter, error) {
+				return ParetoRender(paretoTestLabels, paretoTestValues,
+					SVGOutputOptionFunc(), TitleTextOptionFunc("Complaints"))
+			},
+		},
+		{
+			name: "custom_threshold_dark",
+			render: func() (*Painter, error) {
+				values := make([]float64, len(paretoTestValues))
+				for i, v := range paretoTestValues {
+					values[i] = v * 10
+				}
+				opt := NewParetoChartOption(paretoTestLabels, values, ParetoOption{
+					BarName:   "Count",
+					LineName:  "Share",
+					Threshold: 90,
+				})
+				opt.OutputFormat = ChartOutputSVG
+				opt.Theme = GetTheme(ThemeDark)
+				return Render(opt)
+			},
+		},
+		{
+			name: "no_threshold",
+			render: func() (*Painter, error) {
+				opt := NewParetoChartOption(paretoTestLabels, paretoTestValues,
+					ParetoOption{ShowThreshold: Ptr(false)})
+				opt.OutputFormat = ChartOutputSVG
+				return Render(opt)
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p, err := tt.render()
+			require.NoError(t, err)
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
@@ -11,6 +11,14 @@ import (
 
 const defaultPieRadiusFactor = 0.4
 
+const (
+	// PieRoseTypeRadius renders a rose chart where the sector angle shows the percentage and the radius shows the
+	// value.
+	PieRoseTypeRadius = "radius"
+	// PieRoseTypeArea renders a rose chart where every sector has an equal angle and the radius shows the value.
+	PieRoseTypeArea = "area"
+)
+
 type pieChart struct {
 	p   *Painter
 	opt *PieChartOption
@@ -41,6 +49,11 @@ type PieChartOption struct {
 	Radius string
 	// SegmentGap provides the gap between each pie slice.
 	SegmentGap float64
+	// RoseType renders the pie as a Nightingale rose (polar area) chart, with the sector radius scaled by value
+	// from InnerRadius to Radius. Set to PieRoseTypeRadius or PieRoseTypeArea, default is a standard pie.
+	RoseType string
+	// InnerRadius sets the radius of an empty center, as a percent or absolute value. Default is no center.
+	InnerRadius string
 }
 
 // newPieChart returns a pie chart renderer.
@@ -70,15 +83,32 @@ func newSector(radius float64, index int, value, currentValue, totalValue float6
 	s := sector{
 		value:       value,
 		radius:      radius,
-		startAngle:  chartdraw.PercentToRadians(currentValue/totalValue) - math.Pi/2,
-		delta:       chartdraw.PercentToRadians(value / totalValue),
 		seriesLabel: seriesLabel,
 		color:       color,
 	}
+	s.setSpan(currentValue/totalValue, value/totalValue)
+
+	if !flagIs(false, seriesLabel.Show) { // only set the label if it's being rendered
+		percent := value / totalValue
+		if seriesLabel.LabelFormatter != nil {
+			s.label, s.labelStyle = seriesLabel.LabelFormatter(index, label, s.value)
+		} else if seriesLabel.ValueFormatter != nil {
+			s.label = seriesLabel.ValueFormatter(s.value)
+		} else { // default label
+			s.label = label + ": " + humanize.FtoaWithDigits(percent*100, 2) + "%"
+		}
+	}
+	return s
+}
+
+// setSpan sets the sector angles from the start and sweep as percentages of the full circle.
+func (s *sector) setSpan(startPercent, percent float64) {
+	s.startAngle = chartdraw.PercentToRadians(startPercent) - math.Pi/2
+	s.delta = chartdraw.PercentToRadians(percent)
 	s.midAngle = s.startAngle + s.delta/2
 
 	// determine quadrant based on the mid-percentage of this sector
-	p := (currentValue + value/2) / totalValue
+	p := startPercent + percent/2
 	if p < 0.25 {
 		s.quadrant = 1
 	} else if p < 0.5 {
@@ -89,18 +119,6 @@ func newSector(radius float64, index int, value, currentValue, totalValue float6
 		s.quadrant = 2
 	}
 	s.yCenter = (p > .15 && p < .35) || (p > .65 && p < .85)
-
-	if !flagIs(false, seriesLabel.Show) { // only set the label if it's being rendered
-		percent := value / totalValue
-		if seriesLabel.LabelFormatter != nil {
-			s.label, s.labelStyle = seriesLabel.LabelFormatter(index, label, s.value)
-		} else if seriesLabel.ValueFormatter != nil {
-			s.label = seriesLabel.ValueFormatter(s.value)
-		} else { // default label
-			s.label = label + ": " + humanize.FtoaWithDigits(percent*100, 2) + "%"
-		}
-	}
-	return s
 }
 
 // calculateOuterLabelLines computes the basic line positions for an outer label.
@@ -187,14 +205,39 @@ func (p *pieChart) renderChart(result *defaultRenderResult) (Box, error) {
 		result.renderNoData(opt.Theme)
 		return p.p.box, nil
 	}
+	var innerRadius float64
+	if opt.InnerRadius != "" {
+		var err error
+		innerRadius, err = parseFlexibleValue(opt.InnerRadius, diameter)
+		if err != nil {
+			return BoxZero, fmt.Errorf("invalid InnerRadius: %w", err)
+		}
+		innerRadius = max(min(innerRadius, radius-10), 0)
+	}
+	switch opt.RoseType {
+	case "", PieRoseTypeRadius, PieRoseTypeArea:
+	default:
+		return BoxZero, fmt.Errorf("unsupported pie RoseType %q", opt.RoseType)
+	}
 
 	_, err := renderPie(seriesPainter, cx, cy, diameter, radius, total, true, opt.SeriesList,
-		opt.Theme, opt.SegmentGap, defaultPieRadiusFactor)
+		opt.Theme, opt.SegmentGap, defaultPieRadiusFactor, opt.RoseType, innerRadius)
+	if err == nil && innerRadius > 0 {
+		circleColor := opt.Theme.GetBackgroundColor()
+		if circleColor.IsZero() {
+			circleColor = ColorWhite
+		} else if circleColor.A != 255 {
+			circleColor = circleColor.WithAlpha(255)
+		}
+		seriesPainter.Circle(innerRadius, cx, cy, circleColor, circleColor, 0.0)
+	}
 	return p.p.box, err
 }
 
+// renderPie draws the sectors and outer labels. When a roseType is set each sector radius scales with the value
+// from the innerRadius to the radius, otherwise the series radius is used.
 func renderPie(p *Painter, cx, cy int, space, radius, total float64, renderLabels bool, seriesList PieSeriesList,
-	theme ColorPalette, sliceGap, defaultRadiusFactor float64) ([]sector, error) {
+	theme ColorPalette, sliceGap, defaultRadiusFactor float64, roseType string, innerRadius float64) ([]sector, error) {
 	if len(seriesList) == 0 {
 		return nil, nil
 	} else if total <= 0 {
@@ -209,17 +252,28 @@ func renderPie(p *Painter, cx, cy int, space, radius, total float64, renderLabel
 	labelRadius := radius + float64(labelLineWidth)
 	seriesNames := seriesList.names()
 
+	var maxValue float64
+	for _, series := range seriesList {
+		maxValue = max(maxValue, series.Value)
+	}
+
 	var currentSum float64
 	// organize sectors by quadrant
 	var quadrant1, quadrant2, quadrant3, quadrant4 []sector
 	for index, series := range seriesList {
 		seriesRadius := radius
-		if series.Radius != "" {
+		if roseType != "" {
+			seriesRadius = innerRadius + (radius-innerRadius)*series.Value/maxValue
+		} else if series.Radius != "" {
 			seriesRadius = getFlexibleRadius(space, defaultRadiusFactor, series.Radius)
 		}
 		color := theme.GetSeriesColor(index)
 		s := newSector(seriesRadius, index, series.Value, currentSum, total,
 			seriesNames[index], series.Label, color)
+		if roseType == PieRoseTypeArea {
+			count := float64(len(seriesList))
+			s.setSpan(float64(index)/count, 1/count)
+		}
 
 		switch s.quadrant {
 		case 1:
@@ -581,6 +581,66 @@ func validatePieChartRender(t *testing.T, svgP, pngP *Painter, opt PieChartOptio
 	assertEqualPNGCRC(t, expectedCRC, rasterData)
 }
 
+func TestPieChartRose(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() PieChartOption
+	}{
+		{
+			name: "area",
+			makeOptions: func() PieChartOption {
+				opt := makeBasicPieChartOption()
+				opt.RoseType = PieRoseTypeArea
+				return opt
+			},
+		},
+		{
+			name: "radius_inner_radius",
+			makeOptions: func() PieChartOption {
+				opt := makeBasicPieChartOption()
+				opt.RoseType = PieRoseTypeRadius
+				opt.InnerRadius = "10%"
+				opt.SegmentGap = 2
+				return opt
+			},
+		},
+		{
+			name: "area_zero_value",
+			makeOptions: func() PieChartOption {
+				opt := NewPieChartOptionWithData([]float64{40, 0, 32, 30, 20})
+				opt.RoseType = PieRoseTypeArea
+				opt.InnerRadius = "20"
+				opt.Legend.Show = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "pie_inner_radius",
+			makeOptions: func() PieChartOption {
+				opt := makeBasicPieChartOption()
+				opt.InnerRadius = "15%"
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.PieChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
 func TestPieChartError(t *testing.T) {
 	t.Parallel()
 
@@ -596,6 +656,24 @@ func TestPieChartError(t *testing.T) {
 			},
 			errorMsgContains: "unsupported negative value",
 		},
+		{
+			name: "invalid_rose_type",
+			makeOptions: func() PieChartOption {
+				opt := NewPieChartOptionWithData([]float64{10.0, 1.0})
+				opt.RoseType = "petal"
+				return opt
+			},
+			errorMsgContains: "unsupported pie RoseType",
+		},
+		{
+			name: "invalid_inner_radius",
+			makeOptions: func() PieChartOption {
+				opt := NewPieChartOptionWithData([]float64{10.0, 1.0})
+				opt.InnerRadius = "abc%"
+				return opt
+			},
+			errorMsgContains: "invalid InnerRadius",
+		},
 	}
 
 	for i, tt := range tests {
@@ -0,0 +1,244 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// RadialBarSeries is a single category rendered as a ring in a radial bar chart.
+type RadialBarSeries struct {
+	// Name specifies the category name, used for the label at the start of the ring.
+	Name string
+	// Value is the category value, encoded as the sweep angle of the ring arc. Null values render only the track.
+	Value float64
+}
+
+// RadialBarChartOption defines the options for rendering a radial bar chart, where each category is a concentric
+// ring arc with a sweep angle proportional to its value. Render the chart using Painter.RadialBarChart.
+type RadialBarChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// SeriesList provides the categories, the first rendered as the outermost ring.
+	SeriesList []RadialBarSeries
+	// Max is the value which sweeps the full SweepAngle. Default is the largest value.
+	Max *float64
+	// StartAngle is the angle in degrees the arcs start at, with 0 at three o'clock and increasing
+	// counterclockwise. Default is 90.
+	StartAngle *float64
+	// SweepAngle is the angle in degrees swept clockwise by the Max value. Default is 270.
+	SweepAngle float64
+	// RadiusRing sets the outer radius of the outermost ring, for example "40%". Default is "45%".
+	RadiusRing string
+	// RadiusCenter sets the radius of the empty center within the innermost ring. Default is 25% of RadiusRing.
+	RadiusCenter string
+	// RingGap is the pixel gap between rings. Default is 25% of the space for each ring.
+	RingGap *float64
+	// ShowTrack when set to *false hides the track drawn behind each ring for the full SweepAngle.
+	ShowTrack *bool
+	// TrackColor sets the color of the track. Default is the theme axis split line color.
+	TrackColor Color
+	// RoundedCaps when *true draws the ends of the ring arcs and tracks rounded.
+	RoundedCaps *bool
+	// Label configures the labels drawn before the start of each ring, which default to the name and value.
+	Label SeriesLabel
+	// ValueFormatter defines how float values are rendered to strings for the labels.
+	ValueFormatter ValueFormatter
+}
+
+type radialBarChart struct {
+	p   *Painter
+	opt *RadialBarChartOption
+}
+
+// newRadialBarChart returns a radial bar chart renderer.
+func newRadialBarChart(p *Painter, opt RadialBarChartOption) *radialBarChart {
+	return &radialBarChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewRadialBarChartOptionWithData returns an initialized RadialBarChartOption with a ring for each value.
+func NewRadialBarChartOptionWithData(values []float64) RadialBarChartOption {
+	seriesList := make([]RadialBarSeries, len(values))
+	for i, v := range values {
+		seriesList[i] = RadialBarSeries{Value: v}
+	}
+	return RadialBarChartOption{
+		SeriesList:     seriesList,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// strokeRadialArc strokes an arc centered on the radius, sweeping clockwise on screen from startRadians. Rounded
+// ends are drawn as circles at the arc end points.
+func strokeRadialArc(p *Painter, cx, cy int, radius, startRadians, deltaRadians float64,
+	color Color, width float64, rounded bool) {
+	if deltaRadians <= 0 {
+		return
+	} else if deltaRadians >= 2*math.Pi-1e-9 {
+		p.Circle(radius, cx, cy, ColorTransparent, color, width)
+		return
+	}
+	p.arcTo(cx, cy, radius, radius, startRadians, deltaRadians)
+	p.stroke(color, width)
+	if rounded {
+		for _, angle := range []float64{startRadians, startRadians + deltaRadians} {
+			p.Circle(width/2, cx+int(math.Round(radius*math.Cos(angle))), cy+int(math.Round(radius*math.Sin(angle))),
+				color, color, 0)
+		}
+	}
+}
+
+func (r *radialBarChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := r.p
+	opt := r.opt
+	seriesPainter := result.seriesPainter
+	if len(opt.SeriesList) == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+
+	sweepAngle := opt.SweepAngle
+	if sweepAngle == 0 {
+		sweepAngle = 270
+	} else if sweepAngle < 0 || sweepAngle > 360 {
+		return BoxZero, errors.New("radial bar SweepAngle must be between 0 and 360 degrees")
+	}
+	startAngle := 90.0
+	if opt.StartAngle != nil {
+		startAngle = *opt.StartAngle
+	}
+	startRadians := -startAngle * math.Pi / 180 // screen angle, increasing clockwise
+	sweepRadians := sweepAngle * math.Pi / 180
+
+	var maxValue float64
+	for i, series := range opt.SeriesList {
+		if !isValidExtent(series.Value) {
+			continue
+		} else if series.Value < 0 {
+			return BoxZero, fmt.Errorf("unsupported negative value at series index %d", i)
+		}
+		maxValue = max(maxValue, series.Value)
+	}
+	if opt.Max != nil {
+		maxValue = *opt.Max
+	}
+
+	cx, cy, diameter := circleChartPosition(seriesPainter)
+	radiusRing := getFlexibleRadius(diameter, 0.45, opt.RadiusRing)
+	radiusCenter := radiusRing * 0.25
+	if opt.RadiusCenter != "" {
+		var err error
+		radiusCenter, err = parseFlexibleValue(opt.RadiusCenter, diameter)
+		if err != nil {
+			return BoxZero, fmt.Errorf("invalid RadiusCenter: %w", err)
+		}
+		radiusCenter = min(max(radiusCenter, 0), radiusRing)
+	}
+	ringSpace := (radiusRing - radiusCenter) / float64(len(opt.SeriesList))
+	ringGap := ringSpace * 0.25
+	if opt.RingGap != nil {
+		ringGap = min(max(*opt.RingGap, 0), ringSpace-1)
+	}
+	arcWidth := ringSpace - ringGap
+	if arcWidth < 1 {
+		return BoxZero, errors.New("insufficient space for radial bar rings")
+	}
+
+	rounded := flagIs(true, opt.RoundedCaps)
+	trackColor := opt.TrackColor
+	if trackColor.IsZero() {
+		trackColor = opt.Theme.GetAxisSplitLineColor()
+	}
+	showLabels := !flagIs(false, opt.Label.Show)
+	valueFormatter := getPreferredValueFormatter(opt.Label.ValueFormatter, opt.ValueFormatter)
+	for i, series := range opt.SeriesList {
+		radius := radiusRing - ringSpace*float64(i) - arcWidth/2
+		if !flagIs(false, opt.ShowTrack) {
+			strokeRadialArc(seriesPainter, cx, cy, radius, startRadians, sweepRadians, trackColor, arcWidth, rounded)
+		}
+		if isValidExtent(series.Value) && maxValue > 0 {
+			ratio := min(series.Value/maxValue, 1)
+			strokeRadialArc(seriesPainter, cx, cy, radius, startRadians, ratio*sweepRadians,
+				opt.Theme.GetSeriesColor(i), arcWidth, rounded)
+		}
+		if !showLabels {
+			continue
+		}
+
+		fontStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize,
+			opt.Theme.GetLabelTextColor(), seriesPainter.font)
+		var text string
+		if opt.Label.LabelFormatter != nil {
+			var labelStyle *LabelStyle
+			text, labelStyle = opt.Label.LabelFormatter(i, series.Name, series.Value)
+			if labelStyle != nil {
+				fontStyle = mergeFontStyles(labelStyle.FontStyle, fontStyle)
+			}
+		} else if isValidExtent(series.Value) {
+			text = valueFormatter(series.Value)
+			if series.Name != "" {
+				text = series.Name + ": " + text
+			}
+		} else {
+			text = series.Name
+		}
+		if text == "" {
+			continue
+		}
+		// the label is placed before the arc start, extending away from the sweep direction
+		textBox := seriesPainter.MeasureText(text, 0, fontStyle)
+		cos, sin := math.Cos(startRadians), math.Sin(startRadians)
+		x := cx + int(math.Round(radius*cos))
+		y := cy + int(math.Round(radius*sin)) + textBox.Height()/2
+		offset := 4
+		if rounded {
+			offset += int(arcWidth / 2)
+		}
+		if sin < 0 { // the clockwise sweep from the upper half moves right, labels extend left
+			x -= textBox.Width() + offset
+		} else {
+			x += offset
+		}
+		seriesPainter.Text(text, x, y, 0, fontStyle)
+	}
+	return p.box, nil
+}
+
+func (r *radialBarChart) Render() (Box, error) {
+	p := r.p
+	opt := r.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeRadialBar},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title: opt.Title,
+		legend: &LegendOption{
+			Show: Ptr(false),
+		},
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return r.renderChart(renderResult)
+}
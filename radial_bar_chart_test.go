@@ -0,0 +1,168 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicRadialBarChartOption() RadialBarChartOption {
+	opt := NewRadialBarChartOptionWithData([]float64{82, 64, 45, 91, 30})
+	for i, name := range []string{"Email", "Search", "Social", "Direct", "Referral"} {
+		opt.SeriesList[i].Name = name
+	}
+	return opt
+}
+
+func TestNewRadialBarChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewRadialBarChartOptionWithData([]float64{12, 24, 48})
+
+	require.Len(t, opt.SeriesList, 3)
+	assert.InDelta(t, 24.0, opt.SeriesList[1].Value, 0)
+	assert.Empty(t, opt.SeriesList[0].Name)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.Theme)
+	assert.NotNil(t, opt.ValueFormatter)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.RadialBarChart(opt))
+}
+
+func TestRadialBarChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() RadialBarChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBasicRadialBarChartOption,
+		},
+		{
+			name: "rounded_caps_dark",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.Theme = GetTheme(ThemeDark)
+				opt.Title.Text = "Channels"
+				opt.RoundedCaps = Ptr(true)
+				opt.Max = Ptr(100.0)
+				return opt
+			},
+		},
+		{
+			name: "label_formatter_no_track",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.ShowTrack = Ptr(false)
+				opt.RingGap = Ptr(2.0)
+				opt.RadiusCenter = "10%"
+				theme := opt.Theme
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					return name, &LabelStyle{FontStyle: FontStyle{FontColor: theme.GetSeriesColor(index)}}
+				}
+				return opt
+			},
+		},
+		{
+			name: "full_sweep_custom_track",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.SweepAngle = 360
+				opt.StartAngle = Ptr(0.0)
+				opt.RadiusRing = "30%"
+				opt.TrackColor = ColorLightGray
+				opt.Label.Show = Ptr(false)
+				opt.SeriesList[1].Value = GetNullValue()
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.SeriesList = nil
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.RadialBarChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestRadialBarChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		makeOptions      func() RadialBarChartOption
+		errorMsgContains string
+	}{
+		{
+			name: "negative_value",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.SeriesList[2].Value = -1
+				return opt
+			},
+			errorMsgContains: "unsupported negative value at series index 2",
+		},
+		{
+			name: "sweep_angle",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.SweepAngle = 400
+				return opt
+			},
+			errorMsgContains: "radial bar SweepAngle must be between 0 and 360 degrees",
+		},
+		{
+			name: "radius_center",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.RadiusCenter = "abc"
+				return opt
+			},
+			errorMsgContains: "invalid RadiusCenter",
+		},
+		{
+			name: "insufficient_space",
+			makeOptions: func() RadialBarChartOption {
+				opt := makeBasicRadialBarChartOption()
+				opt.RadiusRing = "2"
+				return opt
+			},
+			errorMsgContains: "insufficient space for radial bar rings",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+
+			err := p.RadialBarChart(tt.makeOptions())
+			require.Error(t, err)
+			require.ErrorContains(t, err, tt.errorMsgContains)
+		})
+	}
+}
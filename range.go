@@ -723,6 +723,34 @@ func niceNumFrom(val float64, nums []float64) float64 {
 	return math.Pow(10, exp+1)
 }
 
+// zeroSpanWidth returns the width to widen a range with no span at the value by. The width is relative to the
+// value, as a constant width is lost to floating point precision for large values.
+func zeroSpanWidth(v float64) float64 {
+	if v == 0 {
+		return zeroSpanAdjustment
+	}
+	return math.Abs(v) * 0.1
+}
+
+// niceAxisLabels returns evenly spaced tick values at a nice interval, spanning the value range with about
+// divideCount intervals.
+func niceAxisLabels(minVal, maxVal float64, divideCount int) []float64 {
+	if maxVal <= minVal {
+		width := zeroSpanWidth(minVal)
+		minVal, maxVal = minVal-width, minVal+width
+	}
+	interval := niceNum((maxVal - minVal) / float64(divideCount))
+	start := math.Floor(minVal/interval) * interval
+	end := math.Ceil(maxVal/interval) * interval
+	// rounding out to the nice interval adds at most one label at each end
+	count := min(max(int(math.Round((end-start)/interval))+1, 2), divideCount+3)
+	labels := make([]float64, count)
+	for i := range labels {
+		labels[i] = start + float64(i)*interval
+	}
+	return labels
+}
+
 // niceNum returns the smallest "nice" number >= val from {1, 2, 2.5, 5} × 10^n.
 func niceNum(val float64) float64 {
 	return niceNumFrom(val, niceNums[:])
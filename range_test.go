@@ -1343,3 +1343,19 @@ func TestAxisLabelQuality(t *testing.T) {
 		assert.Equal(t, 0, s.friendlyInterval)
 	})
 }
+
+func TestNiceAxisLabels(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []float64{0, 20, 40, 60, 80, 100}, niceAxisLabels(3, 97, 5))
+	assert.Equal(t, []float64{4.5, 4.75, 5, 5.25, 5.5}, niceAxisLabels(5, 5, 4))
+
+	labels := niceAxisLabels(1e20, 1e20, 5)
+	require.NotEmpty(t, labels)
+	assert.Less(t, labels[0], 1e20)
+	assert.Greater(t, labels[len(labels)-1], 1e20)
+
+	labels = niceAxisLabels(1e20, math.Nextafter(1e20, math.Inf(1)), 5)
+	assert.GreaterOrEqual(t, len(labels), 2)
+	assert.LessOrEqual(t, len(labels), 8)
+}
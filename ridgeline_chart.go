@@ -0,0 +1,258 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/go-analyze/bulk"
+)
+
+const (
+	defaultRidgelineOverlap    = 0.5
+	defaultRidgelinePointCount = 100
+	// ridgelineMaxOverlap limits the overlap, as each unit of overlap reserves another category of space for the
+	// top ridge.
+	ridgelineMaxOverlap = 10
+	// ridgelineTailBandwidths is the number of bandwidths the value range extends beyond the samples, so the density
+	// tails approach zero within the axis.
+	ridgelineTailBandwidths = 3
+)
+
+// RidgelineSeries provides the samples for a single category of a ridgeline chart.
+type RidgelineSeries struct {
+	// Name specifies the category name, shown on the category axis.
+	Name string
+	// Samples provides the raw sample values, rendered as a kernel density estimate. Null values are ignored.
+	Samples []float64
+}
+
+// RidgelineChartOption defines the options for rendering a ridgeline (joy plot) chart. Each category renders the
+// kernel density estimate of its samples as a ridge, stacked vertically and sharing the numeric value axis. Render
+// the chart using Painter.RidgelineChart.
+type RidgelineChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// SeriesList provides the samples for each category, the first rendered at the bottom.
+	SeriesList []RidgelineSeries
+	// CategoryAxis configures the vertical category axis. Labels default to the series names.
+	CategoryAxis CategoryAxisOption
+	// ValueAxis configures the horizontal numeric axis shared by all ridges.
+	ValueAxis ValueAxisOption
+	// Overlap is the fraction of the category spacing each ridge may extend over the ridges above. Default is 0.5,
+	// the maximum is 10.
+	Overlap *float64
+	// Normalization selects how ridge heights are scaled: ViolinNormalizationPerSeries (default) scales each ridge
+	// to its own density max, ViolinNormalizationGlobal scales all ridges to the shared density max.
+	Normalization string
+	// Bandwidth overrides the KDE bandwidth, otherwise Silverman's rule of thumb is used for each series.
+	Bandwidth *float64
+	// PointCount is the number of points each density curve is evaluated at. Default is 100.
+	PointCount int
+	// FillOpacity is the opacity/alpha (0-255) of the ridge fill. Default is 200.
+	FillOpacity uint8
+	// LineStrokeWidth is the stroke width of the ridge outline. Default is 1.5.
+	LineStrokeWidth float64
+	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
+	ValueFormatter ValueFormatter
+}
+
+type ridgelineChart struct {
+	p   *Painter
+	opt *RidgelineChartOption
+}
+
+// newRidgelineChart returns a ridgeline chart renderer.
+func newRidgelineChart(p *Painter, opt RidgelineChartOption) *ridgelineChart {
+	return &ridgelineChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewRidgelineChartOptionWithSamples returns an initialized RidgelineChartOption with a category for each set of
+// samples.
+func NewRidgelineChartOptionWithSamples(samples [][]float64) RidgelineChartOption {
+	seriesList := make([]RidgelineSeries, len(samples))
+	for i, s := range samples {
+		seriesList[i] = RidgelineSeries{Samples: s}
+	}
+	return RidgelineChartOption{
+		SeriesList:     seriesList,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// ridgelineDensity contains the filtered samples and KDE bandwidth for a ridge.
+type ridgelineDensity struct {
+	samples   []float64
+	bandwidth float64
+}
+
+// ridgelineDensities returns the filtered samples and bandwidth for each series. Series without enough data to
+// estimate a density have a zero bandwidth.
+func ridgelineDensities(seriesList []RidgelineSeries, bandwidthOverride *float64) []ridgelineDensity {
+	densities := make([]ridgelineDensity, len(seriesList))
+	for i, series := range seriesList {
+		filtered := bulk.SliceFilter(isValidExtent, series.Samples)
+		if len(filtered) == 0 {
+			continue
+		}
+		densities[i].samples = filtered
+		densities[i].bandwidth = kdeBandwidth(summarizePopulationData(filtered), len(filtered), bandwidthOverride)
+	}
+	return densities
+}
+
+func (r *ridgelineChart) renderChart(result *defaultRenderResult, densities []ridgelineDensity,
+	overlap float64) (Box, error) {
+	p := r.p
+	opt := r.opt
+	seriesPainter := result.seriesPainter
+	categoryRange := result.categoryAxisRange
+	valueRange := result.valueAxisRanges[0]
+	if categoryRange.divideCount == 0 {
+		return BoxZero, errors.New("ridgeline category axis produced no slots")
+	}
+
+	pointCount := opt.PointCount
+	if pointCount <= 1 {
+		pointCount = defaultRidgelinePointCount
+	}
+	opacity := uint8(200)
+	if opt.FillOpacity > 0 {
+		opacity = opt.FillOpacity
+	}
+	strokeWidth := opt.LineStrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1.5
+	}
+
+	// densities are evaluated across the full axis so each ridge outline spans the shared value range
+	curves := make([][]float64, len(densities))
+	var globalMax float64
+	for i, density := range densities {
+		if density.bandwidth == 0 {
+			continue
+		}
+		curves[i] = gaussianKDERange(density.samples, valueRange.min, valueRange.max, pointCount, density.bandwidth)
+		globalMax = max(globalMax, slices.Max(curves[i]))
+	}
+
+	divideValues := categoryRange.autoDivide()
+	slotSize := float64(divideValues[1] - divideValues[0])
+	ridgeHeight := slotSize * (1 + overlap)
+	left, right := valueRange.valuePosition(valueRange.min), valueRange.valuePosition(valueRange.max)
+	// ridges are drawn from the top down so lower ridges overlay the ridges behind them
+	for index := min(len(curves), categoryRange.divideCount) - 1; index >= 0; index-- {
+		curve := curves[index]
+		if len(curve) == 0 {
+			continue
+		}
+		normMax := slices.Max(curve)
+		if opt.Normalization == ViolinNormalizationGlobal {
+			normMax = globalMax
+		}
+		if normMax <= 0 {
+			continue
+		}
+		slot := categoryRange.divideCount - index - 1 // reversed so the first category is at the bottom
+		baseline := (divideValues[slot] + divideValues[slot+1]) >> 1
+
+		points := make([]Point, len(curve), len(curve)+2)
+		for j, d := range curve {
+			x := valueRange.min + (valueRange.max-valueRange.min)*float64(j)/float64(len(curve)-1)
+			points[j] = Point{
+				X: valueRange.valuePosition(x),
+				Y: baseline - int(math.Round(d/normMax*ridgeHeight)),
+			}
+		}
+		color := opt.Theme.GetSeriesColor(index)
+		seriesPainter.FillArea(append(points, Point{X: right, Y: baseline}, Point{X: left, Y: baseline}),
+			color.WithAlpha(opacity))
+		seriesPainter.LineStroke(points, color, strokeWidth)
+	}
+	return p.box, nil
+}
+
+func (r *ridgelineChart) Render() (Box, error) {
+	p := r.p
+	opt := r.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	switch opt.Normalization {
+	case "", ViolinNormalizationPerSeries, ViolinNormalizationGlobal:
+	default:
+		return BoxZero, fmt.Errorf("unsupported ridgeline Normalization %q", opt.Normalization)
+	}
+	overlap := defaultRidgelineOverlap
+	if opt.Overlap != nil {
+		overlap = *opt.Overlap
+		if overlap < 0 {
+			return BoxZero, errors.New("ridgeline Overlap must not be negative")
+		} else if !(overlap <= ridgelineMaxOverlap) { // also rejects NaN
+			return BoxZero, fmt.Errorf("ridgeline Overlap must not exceed %d", ridgelineMaxOverlap)
+		}
+	}
+
+	densities := ridgelineDensities(opt.SeriesList, opt.Bandwidth)
+	var values []float64
+	for _, density := range densities {
+		if density.bandwidth == 0 {
+			continue
+		}
+		tail := density.bandwidth * ridgelineTailBandwidths
+		values = append(values, slices.Min(density.samples)-tail, slices.Max(density.samples)+tail)
+	}
+
+	// one label per series, so the empty overlap categories follow the last series
+	categoryAxis := opt.CategoryAxis
+	labels := make([]string, len(opt.SeriesList))
+	for i, series := range opt.SeriesList {
+		if i < len(categoryAxis.Labels) {
+			labels[i] = categoryAxis.Labels[i]
+		} else if series.Name != "" {
+			labels[i] = series.Name
+		} else {
+			labels[i] = strconv.Itoa(i + 1)
+		}
+	}
+	categoryAxis.Labels = labels
+	if len(values) != 0 {
+		// empty categories above the top ridge provide the space for it to extend into
+		for i := 0; i < int(math.Ceil(overlap+0.5)); i++ {
+			categoryAxis.Labels = append(categoryAxis.Labels, "")
+		}
+	}
+	valueAxis := []ValueAxisOption{opt.ValueAxis}
+	normalizeBarAxisPositions(true, &categoryAxis, valueAxis)
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:          opt.Theme,
+		padding:        opt.Padding,
+		seriesList:     valuesFakeSeries{values: values},
+		categoryAxis:   &categoryAxis,
+		valueAxis:      valueAxis,
+		title:          opt.Title,
+		legend:         &LegendOption{Show: Ptr(false)},
+		valueFormatter: opt.ValueFormatter,
+		categoryY:      true,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	if len(values) == 0 {
+		renderResult.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	return r.renderChart(renderResult, densities, overlap)
+}
@@ -0,0 +1,194 @@
+package charts
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicRidgelineChartOption() RidgelineChartOption {
+	r := rand.New(rand.NewSource(7))
+	samples := make([][]float64, 7)
+	for i := range samples {
+		samples[i] = make([]float64, 150)
+		for j := range samples[i] {
+			samples[i][j] = 120 + float64(i)*8 + r.NormFloat64()*20
+			if j%4 == 0 { // slow tail of requests
+				samples[i][j] += 80
+			}
+		}
+	}
+	opt := NewRidgelineChartOptionWithSamples(samples)
+	for i, name := range []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"} {
+		opt.SeriesList[i].Name = name
+	}
+	return opt
+}
+
+func TestNewRidgelineChartOptionWithSamples(t *testing.T) {
+	t.Parallel()
+
+	opt := NewRidgelineChartOptionWithSamples([][]float64{{1, 2, 3}, {4, 5, 6}})
+
+	require.Len(t, opt.SeriesList, 2)
+	assert.Equal(t, []float64{4, 5, 6}, opt.SeriesList[1].Samples)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.Theme)
+	assert.NotNil(t, opt.ValueFormatter)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.RidgelineChart(opt))
+}
+
+func TestRidgelineDensities(t *testing.T) {
+	t.Parallel()
+
+	densities := ridgelineDensities([]RidgelineSeries{
+		{Samples: []float64{1, 2, 3, GetNullValue()}},
+		{Samples: []float64{5, 5, 5}},
+		{},
+	}, nil)
+	require.Len(t, densities, 3)
+	assert.Equal(t, []float64{1, 2, 3}, densities[0].samples)
+	assert.Greater(t, densities[0].bandwidth, 0.0)
+	assert.Len(t, densities[1].samples, 3)
+	assert.Zero(t, densities[1].bandwidth) // no variance to estimate from
+	assert.Empty(t, densities[2].samples)
+
+	densities = ridgelineDensities([]RidgelineSeries{{Samples: []float64{5, 5, 5}}}, Ptr(2.0))
+	assert.InDelta(t, 2.0, densities[0].bandwidth, 0)
+}
+
+func TestRidgelineChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() RidgelineChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBasicRidgelineChartOption,
+		},
+		{
+			name: "overlap_global_dark",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.Theme = GetTheme(ThemeDark)
+				opt.Title.Text = "Latency"
+				opt.Overlap = Ptr(1.5)
+				opt.Normalization = ViolinNormalizationGlobal
+				return opt
+			},
+		},
+		{
+			name: "no_overlap_opacity",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.Overlap = Ptr(0.0)
+				opt.FillOpacity = 80
+				opt.LineStrokeWidth = 2
+				opt.PointCount = 40
+				return opt
+			},
+		},
+		{
+			name: "bandwidth_axis_range",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.Bandwidth = Ptr(4.0)
+				opt.ValueAxis.Min = Ptr(50.0)
+				opt.ValueAxis.Max = Ptr(300.0)
+				opt.CategoryAxis.Labels = []string{"1", "2", "3", "4", "5", "6", "7"}
+				opt.SeriesList[3].Samples = nil
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.SeriesList = nil
+				return opt
+			},
+		},
+		{
+			name: "short_labels",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.CategoryAxis.Labels = []string{"Monday", "Tuesday"}
+				opt.SeriesList[6].Name = ""
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.RidgelineChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestRidgelineChartError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		makeOptions      func() RidgelineChartOption
+		errorMsgContains string
+	}{
+		{
+			name: "normalization",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.Normalization = "invalid"
+				return opt
+			},
+			errorMsgContains: `unsupported ridgeline Normalization "invalid"`,
+		},
+		{
+			name: "negative_overlap",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.Overlap = Ptr(-1.0)
+				return opt
+			},
+			errorMsgContains: "ridgeline Overlap must not be negative",
+		},
+		{
+			name: "large_overlap",
+			makeOptions: func() RidgelineChartOption {
+				opt := makeBasicRidgelineChartOption()
+				opt.Overlap = Ptr(1e8)
+				return opt
+			},
+			errorMsgContains: "ridgeline Overlap must not exceed 10",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+
+			err := p.RidgelineChart(tt.makeOptions())
+			require.Error(t, err)
+			require.ErrorContains(t, err, tt.errorMsgContains)
+		})
+	}
+}
@@ -0,0 +1,437 @@
+package charts
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+const (
+	defaultSankeyNodeWidth  = 16
+	defaultSankeyNodeGap    = 10
+	defaultSankeyIterations = 6
+)
+
+// SankeyNode is a node of a sankey chart which links flow between.
+type SankeyNode struct {
+	// Name is the node name, rendered as the node label and referenced by links.
+	Name string
+}
+
+// SankeyLink is a weighted flow between two nodes of a sankey chart.
+type SankeyLink struct {
+	// Source is the name of the node the flow leaves.
+	Source string
+	// Target is the name of the node the flow enters.
+	Target string
+	// Value is the size of the flow, determining the link width.
+	Value float64
+}
+
+// SankeyChartOption defines the options for rendering a sankey chart. Render the chart using Painter.SankeyChart.
+type SankeyChartOption struct {
+	// Theme specifies the colors used for the chart. Each node is assigned a series color.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Nodes provides the chart nodes, the order is used for colors and the initial layout. Nodes referenced by
+	// links but not listed are added after the listed nodes.
+	Nodes []SankeyNode
+	// Links provides the flows between nodes. Links must not form a cycle.
+	Links []SankeyLink
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the legend of nodes. The legend is only shown when Show is *true.
+	Legend LegendOption
+	// NodeWidth specifies the pixel width of each node. Default is 16.
+	NodeWidth int
+	// NodeGap specifies the vertical pixel gap between nodes in the same column. Default is 10.
+	NodeGap int
+	// Iterations specifies how many passes are made reordering nodes to reduce link crossings. Default is 6.
+	Iterations int
+	// Label configures the node labels, which default to the node name. The LabelFormatter index is the node index.
+	Label SeriesLabel
+}
+
+type sankeyChart struct {
+	p   *Painter
+	opt *SankeyChartOption
+}
+
+// newSankeyChart returns a sankey chart renderer.
+func newSankeyChart(p *Painter, opt SankeyChartOption) *sankeyChart {
+	return &sankeyChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewSankeyChartOptionWithData returns an initialized SankeyChartOption with the provided links, nodes are
+// derived from the link source and target names.
+func NewSankeyChartOptionWithData(links []SankeyLink) SankeyChartOption {
+	return SankeyChartOption{
+		Links:   links,
+		Padding: defaultPadding,
+		Theme:   GetDefaultTheme(),
+	}
+}
+
+// sankeyNodeLayout is the computed column and vertical position of a node.
+type sankeyNodeLayout struct {
+	name   string
+	column int
+	value  float64
+	y      float64
+	height float64
+}
+
+func (n *sankeyNodeLayout) center() float64 {
+	return n.y + n.height/2
+}
+
+// sankeyLinkLayout is the computed band position of a link at its source and target node.
+type sankeyLinkLayout struct {
+	source, target int
+	value          float64
+	sourceY        float64
+	targetY        float64
+	width          float64
+}
+
+// sankeyLayout holds the positioned nodes and links of a sankey chart.
+type sankeyLayout struct {
+	nodes       []sankeyNodeLayout
+	links       []sankeyLinkLayout
+	columns     [][]int
+	columnCount int
+}
+
+// sankeyNodeNames returns the listed node names followed by any additional names referenced by the links.
+func sankeyNodeNames(nodes []SankeyNode, links []SankeyLink) ([]string, map[string]int) {
+	var names []string
+	nameIndex := make(map[string]int)
+	add := func(name string) {
+		if _, ok := nameIndex[name]; !ok {
+			nameIndex[name] = len(names)
+			names = append(names, name)
+		}
+	}
+	for _, node := range nodes {
+		add(node.Name)
+	}
+	for _, link := range links {
+		add(link.Source)
+		add(link.Target)
+	}
+	return names, nameIndex
+}
+
+// newSankeyLayout assigns each node to a column by the longest path from a source node, with sink nodes aligned
+// to the last column. Node values are the larger of the incoming and outgoing flow.
+func newSankeyLayout(nodes []SankeyNode, links []SankeyLink) (*sankeyLayout, error) {
+	names, nameIndex := sankeyNodeNames(nodes, links)
+	layout := &sankeyLayout{
+		nodes: make([]sankeyNodeLayout, len(names)),
+	}
+	for i, name := range names {
+		layout.nodes[i].name = name
+	}
+	inValues := make([]float64, len(names))
+	outValues := make([]float64, len(names))
+	inDegree := make([]int, len(names))
+	outLinks := make([][]int, len(names))
+	for _, link := range links {
+		if link.Value < 0 {
+			return nil, fmt.Errorf("unsupported negative value for sankey link %q to %q", link.Source, link.Target)
+		} else if !isValidExtent(link.Value) || link.Value == 0 {
+			continue
+		}
+		source, target := nameIndex[link.Source], nameIndex[link.Target]
+		outLinks[source] = append(outLinks[source], len(layout.links))
+		inDegree[target]++
+		outValues[source] += link.Value
+		inValues[target] += link.Value
+		layout.links = append(layout.links, sankeyLinkLayout{
+			source: source,
+			target: target,
+			value:  link.Value,
+		})
+	}
+
+	// topological walk assigning the longest path depth as the column
+	queue := make([]int, 0, len(names))
+	for i := range names {
+		if inDegree[i] == 0 {
+			queue = append(queue, i)
+		}
+	}
+	var visited int
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, l := range outLinks[i] {
+			target := layout.links[l].target
+			layout.nodes[target].column = max(layout.nodes[target].column, layout.nodes[i].column+1)
+			if inDegree[target]--; inDegree[target] == 0 {
+				queue = append(queue, target)
+			}
+		}
+	}
+	if visited != len(names) {
+		return nil, errors.New("sankey links must not form a cycle")
+	}
+
+	for i := range layout.nodes {
+		layout.columnCount = max(layout.columnCount, layout.nodes[i].column+1)
+		layout.nodes[i].value = max(inValues[i], outValues[i])
+	}
+	layout.columns = make([][]int, layout.columnCount)
+	for i := range layout.nodes {
+		if len(outLinks[i]) == 0 && inValues[i] > 0 {
+			layout.nodes[i].column = layout.columnCount - 1
+		}
+		column := layout.nodes[i].column
+		layout.columns[column] = append(layout.columns[column], i)
+	}
+	return layout, nil
+}
+
+// position sizes the nodes to fit the height, then iteratively reorders each column by the weighted center of the
+// links from the prior column, followed by a backward pass using the following column, to reduce link crossings.
+func (l *sankeyLayout) position(height, nodeGap float64, iterations int) {
+	scale := math.MaxFloat64
+	for _, column := range l.columns {
+		var total float64
+		for _, i := range column {
+			total += l.nodes[i].value
+		}
+		if total > 0 {
+			scale = min(scale, (height-nodeGap*float64(len(column)-1))/total)
+		}
+	}
+	if scale == math.MaxFloat64 || scale < 0 {
+		scale = 0
+	}
+	for i := range l.nodes {
+		l.nodes[i].height = l.nodes[i].value * scale
+	}
+	for i := range l.links {
+		l.links[i].width = l.links[i].value * scale
+	}
+	for _, column := range l.columns {
+		l.stackColumn(column, height, nodeGap)
+	}
+	l.stackLinks()
+
+	for iteration := 0; iteration < iterations; iteration++ {
+		for c := 1; c < len(l.columns); c++ {
+			l.sortColumn(l.columns[c], true)
+			l.stackColumn(l.columns[c], height, nodeGap)
+			l.stackLinks()
+		}
+		for c := len(l.columns) - 2; c >= 0; c-- {
+			l.sortColumn(l.columns[c], false)
+			l.stackColumn(l.columns[c], height, nodeGap)
+			l.stackLinks()
+		}
+	}
+}
+
+// stackLinks positions the link bands on each node, ordered by the position of the node at the other end.
+func (l *sankeyLayout) stackLinks() {
+	outgoing := make([][]int, len(l.nodes))
+	incoming := make([][]int, len(l.nodes))
+	for i, link := range l.links {
+		outgoing[link.source] = append(outgoing[link.source], i)
+		incoming[link.target] = append(incoming[link.target], i)
+	}
+	for i := range l.nodes {
+		slices.SortStableFunc(outgoing[i], func(a, b int) int {
+			return cmp.Compare(l.nodes[l.links[a].target].center(), l.nodes[l.links[b].target].center())
+		})
+		y := l.nodes[i].y
+		for _, li := range outgoing[i] {
+			l.links[li].sourceY = y
+			y += l.links[li].width
+		}
+		slices.SortStableFunc(incoming[i], func(a, b int) int {
+			return cmp.Compare(l.nodes[l.links[a].source].center(), l.nodes[l.links[b].source].center())
+		})
+		y = l.nodes[i].y
+		for _, li := range incoming[i] {
+			l.links[li].targetY = y
+			y += l.links[li].width
+		}
+	}
+}
+
+// sortColumn orders the column nodes by the value weighted center of the link bands at the other end, using the
+// incoming links when set, otherwise the outgoing links. Nodes without links keep their current center.
+func (l *sankeyLayout) sortColumn(column []int, incoming bool) {
+	centers := make(map[int]float64, len(column))
+	for _, i := range column {
+		var weighted, total float64
+		for _, link := range l.links {
+			if incoming && link.target == i {
+				weighted += (link.sourceY + link.width/2) * link.value
+				total += link.value
+			} else if !incoming && link.source == i {
+				weighted += (link.targetY + link.width/2) * link.value
+				total += link.value
+			}
+		}
+		if total > 0 {
+			centers[i] = weighted / total
+		} else {
+			centers[i] = l.nodes[i].center()
+		}
+	}
+	slices.SortStableFunc(column, func(a, b int) int {
+		return cmp.Compare(centers[a], centers[b])
+	})
+}
+
+// stackColumn positions the column nodes in order, separated by the gap and centered vertically.
+func (l *sankeyLayout) stackColumn(column []int, height, nodeGap float64) {
+	total := nodeGap * float64(len(column)-1)
+	for _, i := range column {
+		total += l.nodes[i].height
+	}
+	y := max((height-total)/2, 0)
+	for _, i := range column {
+		l.nodes[i].y = y
+		y += l.nodes[i].height + nodeGap
+	}
+}
+
+func (s *sankeyChart) renderChart(result *defaultRenderResult) (Box, error) {
+	opt := s.opt
+	layout, err := newSankeyLayout(opt.Nodes, opt.Links)
+	if err != nil {
+		return BoxZero, err
+	} else if len(layout.links) == 0 {
+		result.renderNoData(opt.Theme)
+		return s.p.box, nil
+	}
+
+	seriesPainter := result.seriesPainter
+	nodeWidth := getDefaultInt(opt.NodeWidth, defaultSankeyNodeWidth)
+	nodeGap := getDefaultInt(opt.NodeGap, defaultSankeyNodeGap)
+	iterations := getDefaultInt(opt.Iterations, defaultSankeyIterations)
+	layout.position(float64(seriesPainter.Height()), float64(nodeGap), iterations)
+
+	columnX := func(column int) int {
+		if layout.columnCount <= 1 {
+			return 0
+		}
+		return column * (seriesPainter.Width() - nodeWidth) / (layout.columnCount - 1)
+	}
+
+	// links are drawn first so the nodes cover the band ends
+	for _, link := range layout.links {
+		x0 := columnX(layout.nodes[link.source].column) + nodeWidth
+		x1 := columnX(layout.nodes[link.target].column)
+		color := opt.Theme.GetSeriesColor(link.source).WithAlpha(100)
+		s.drawBand(seriesPainter, x0, x1, link.sourceY, link.targetY, link.width, color)
+	}
+
+	for i, node := range layout.nodes {
+		x := columnX(node.column)
+		top := int(math.Round(node.y))
+		bottom := max(int(math.Round(node.y+node.height)), top+1)
+		color := opt.Theme.GetSeriesColor(i)
+		seriesPainter.FilledRect(x, top, x+nodeWidth, bottom, color, color, 0.0)
+	}
+
+	if !flagIs(false, opt.Label.Show) {
+		for i, node := range layout.nodes {
+			s.renderLabel(seriesPainter, i, node, columnX(node.column), nodeWidth, node.column == layout.columnCount-1)
+		}
+	}
+	return s.p.box, nil
+}
+
+// drawBand fills a link as an s-curve band from the source edge x0 to the target edge x1. Each edge of the band
+// is drawn as two quadratic curves meeting at the midpoint with a continuous tangent.
+func (s *sankeyChart) drawBand(p *Painter, x0, x1 int, sourceY, targetY, width float64, color Color) {
+	xm := (x0 + x1) / 2
+	y0, y1 := int(math.Round(sourceY)), int(math.Round(targetY))
+	w := max(int(math.Round(width)), 1)
+	p.moveTo(x0, y0)
+	p.quadCurveTo((x0+xm)/2, y0, xm, (y0+y1)/2)
+	p.quadCurveTo((xm+x1)/2, y1, x1, y1)
+	p.lineTo(x1, y1+w)
+	p.quadCurveTo((xm+x1)/2, y1+w, xm, (y0+y1)/2+w)
+	p.quadCurveTo((x0+xm)/2, y0+w, x0, y0+w)
+	p.close()
+	p.fill(color)
+}
+
+// renderLabel draws the node label vertically centered beside the node, to the left for the last column.
+func (s *sankeyChart) renderLabel(p *Painter, index int, node sankeyNodeLayout, x, nodeWidth int, left bool) {
+	opt := s.opt
+	var text string
+	fontStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize,
+		opt.Theme.GetLabelTextColor(), p.font)
+	if opt.Label.LabelFormatter != nil {
+		var labelStyle *LabelStyle
+		text, labelStyle = opt.Label.LabelFormatter(index, node.name, node.value)
+		if labelStyle != nil {
+			fontStyle = mergeFontStyles(labelStyle.FontStyle, fontStyle)
+		}
+	} else if opt.Label.ValueFormatter != nil {
+		text = node.name + " " + opt.Label.ValueFormatter(node.value)
+	} else {
+		text = node.name
+	}
+	if text == "" {
+		return
+	}
+
+	textBox := p.MeasureText(text, 0, fontStyle)
+	textX := x + nodeWidth + 4
+	if left {
+		textX = x - 4 - textBox.Width()
+	}
+	textY := int(math.Round(node.center())) + textBox.Height()/2
+	p.Text(text, textX, textY, 0, fontStyle)
+}
+
+func (s *sankeyChart) Render() (Box, error) {
+	p := s.p
+	opt := s.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+	legend := opt.Legend
+	if legend.Show == nil {
+		legend.Show = Ptr(false) // node labels already name each node
+	}
+
+	names, _ := sankeyNodeNames(opt.Nodes, opt.Links)
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeSankey, seriesNames: names},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title:  opt.Title,
+		legend: &legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return s.renderChart(renderResult)
+}
@@ -0,0 +1,213 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicSankeyLinks() []SankeyLink {
+	return []SankeyLink{
+		{Source: "Salary", Target: "Budget", Value: 5000},
+		{Source: "Bonus", Target: "Budget", Value: 1200},
+		{Source: "Interest", Target: "Budget", Value: 300},
+		{Source: "Budget", Target: "Housing", Value: 2000},
+		{Source: "Budget", Target: "Food", Value: 900},
+		{Source: "Budget", Target: "Savings", Value: 2100},
+		{Source: "Budget", Target: "Transport", Value: 600},
+		{Source: "Budget", Target: "Other", Value: 900},
+		{Source: "Savings", Target: "Stocks", Value: 1500},
+		{Source: "Savings", Target: "Cash", Value: 600},
+		{Source: "Bonus", Target: "Stocks", Value: 200},
+	}
+}
+
+func TestNewSankeyChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewSankeyChartOptionWithData(makeBasicSankeyLinks())
+
+	assert.Len(t, opt.Links, 11)
+	assert.Equal(t, defaultPadding, opt.Padding)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.SankeyChart(opt))
+}
+
+func TestNewSankeyLayout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("columns", func(t *testing.T) {
+		layout, err := newSankeyLayout([]SankeyNode{{Name: "Unused"}, {Name: "Budget"}}, makeBasicSankeyLinks())
+		require.NoError(t, err)
+		assert.Equal(t, 4, layout.columnCount)
+
+		columns := make(map[string]int)
+		values := make(map[string]float64)
+		for _, node := range layout.nodes {
+			columns[node.name] = node.column
+			values[node.name] = node.value
+		}
+		assert.Equal(t, "Unused", layout.nodes[0].name)
+		assert.Equal(t, "Budget", layout.nodes[1].name)
+		assert.Equal(t, 0, columns["Salary"])
+		assert.Equal(t, 1, columns["Budget"])
+		assert.Equal(t, 2, columns["Savings"])
+		assert.Equal(t, 3, columns["Housing"]) // sinks are aligned to the last column
+		assert.Equal(t, 3, columns["Stocks"])
+		assert.Equal(t, 0, columns["Unused"])
+		assert.InDelta(t, 6500.0, values["Budget"], 0)
+		assert.InDelta(t, 1400.0, values["Bonus"], 0)
+		assert.InDelta(t, 1700.0, values["Stocks"], 0)
+		assert.InDelta(t, 0.0, values["Unused"], 0)
+	})
+	t.Run("cycle", func(t *testing.T) {
+		_, err := newSankeyLayout(nil, []SankeyLink{
+			{Source: "a", Target: "b", Value: 1},
+			{Source: "b", Target: "c", Value: 1},
+			{Source: "c", Target: "a", Value: 1},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle")
+	})
+	t.Run("negative", func(t *testing.T) {
+		_, err := newSankeyLayout(nil, []SankeyLink{{Source: "a", Target: "b", Value: -1}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported negative value for sankey link "a" to "b"`)
+	})
+}
+
+func TestSankeyLayoutPosition(t *testing.T) {
+	t.Parallel()
+
+	layout, err := newSankeyLayout(nil, makeBasicSankeyLinks())
+	require.NoError(t, err)
+	layout.position(300, 10, defaultSankeyIterations)
+
+	for _, column := range layout.columns {
+		for i := 1; i < len(column); i++ {
+			prior, node := layout.nodes[column[i-1]], layout.nodes[column[i]]
+			assert.InDelta(t, prior.y+prior.height+10, node.y, 1e-9)
+		}
+		last := layout.nodes[column[len(column)-1]]
+		assert.LessOrEqual(t, last.y+last.height, 300+1e-9)
+	}
+	for _, link := range layout.links {
+		source, target := layout.nodes[link.source], layout.nodes[link.target]
+		assert.GreaterOrEqual(t, link.sourceY, source.y-1e-9)
+		assert.LessOrEqual(t, link.sourceY+link.width, source.y+source.height+1e-9)
+		assert.GreaterOrEqual(t, link.targetY, target.y-1e-9)
+		assert.LessOrEqual(t, link.targetY+link.width, target.y+target.height+1e-9)
+	}
+}
+
+func TestSankeyChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() SankeyChartOption
+	}{
+		{
+			name: "basic",
+			makeOptions: func() SankeyChartOption {
+				return NewSankeyChartOptionWithData(makeBasicSankeyLinks())
+			},
+		},
+		{
+			name: "title_legend_dark_theme",
+			makeOptions: func() SankeyChartOption {
+				opt := NewSankeyChartOptionWithData(makeBasicSankeyLinks())
+				opt.Title = TitleOption{Text: "Monthly budget"}
+				opt.Legend.Show = Ptr(true)
+				opt.Legend.Offset = OffsetRight
+				opt.Theme = GetTheme(ThemeVividDark)
+				return opt
+			},
+		},
+		{
+			name: "node_size",
+			makeOptions: func() SankeyChartOption {
+				opt := NewSankeyChartOptionWithData(makeBasicSankeyLinks())
+				opt.NodeWidth = 6
+				opt.NodeGap = 24
+				opt.Iterations = 1
+				return opt
+			},
+		},
+		{
+			name: "label_formatter",
+			makeOptions: func() SankeyChartOption {
+				opt := NewSankeyChartOptionWithData(makeBasicSankeyLinks())
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					return name + " " + strconv.Itoa(int(val)), &LabelStyle{
+						FontStyle: FontStyle{FontColor: ColorBlue},
+					}
+				}
+				return opt
+			},
+		},
+		{
+			name: "hidden_labels",
+			makeOptions: func() SankeyChartOption {
+				opt := NewSankeyChartOptionWithData(makeBasicSankeyLinks())
+				opt.Label.Show = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "user_journey",
+			makeOptions: func() SankeyChartOption {
+				opt := NewSankeyChartOptionWithData([]SankeyLink{
+					{Source: "Search", Target: "Landing", Value: 620},
+					{Source: "Ads", Target: "Landing", Value: 300},
+					{Source: "Ads", Target: "Pricing", Value: 90},
+					{Source: "Landing", Target: "Pricing", Value: 410},
+					{Source: "Landing", Target: "Exit", Value: 510},
+					{Source: "Pricing", Target: "Signup", Value: 230},
+					{Source: "Pricing", Target: "Exit", Value: 270},
+				})
+				opt.Nodes = []SankeyNode{{Name: "Ads"}, {Name: "Search"}}
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() SankeyChartOption {
+				return NewSankeyChartOptionWithData([]SankeyLink{{Source: "a", Target: "b"}})
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.SankeyChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestSankeyChartError(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	})
+	err := p.SankeyChart(NewSankeyChartOptionWithData([]SankeyLink{
+		{Source: "a", Target: "b", Value: 1},
+		{Source: "b", Target: "a", Value: 1},
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must not form a cycle")
+}
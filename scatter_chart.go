@@ -1,12 +1,16 @@
 package charts
 
 import (
+	"cmp"
 	"math"
+	"slices"
 )
 
 type scatterChart struct {
 	p   *Painter
 	opt *ScatterChartOption
+	// sizeLegendWidth is the space reserved on the right for the bubble size legend.
+	sizeLegendWidth int
 }
 
 // newScatterChart returns a scatter chart renderer.
@@ -43,7 +47,7 @@ type ScatterChartOption struct {
 	// Padding specifies the padding around the chart.
 	Padding Box
 	// SeriesList provides the data population for the chart. Typically constructed using
-	// NewSeriesListScatter or NewSeriesListScatterMultiValue.
+	// NewSeriesListScatter, NewSeriesListScatterMultiValue, or NewSeriesListBubble.
 	SeriesList ScatterSeriesList
 	// XAxis contains options for the x-axis.
 	XAxis XAxisOption
@@ -58,10 +62,149 @@ type ScatterChartOption struct {
 	Symbol Symbol
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
+	// BubbleSize configures the radius scale and size legend for series which provide Sizes. The value axis range
+	// is padded to fit the bubbles, and the XAxis BoundaryGap defaults to true.
+	BubbleSize BubbleSizeOption
+}
+
+// BubbleSizeOption configures how the series Sizes are mapped to a symbol radius.
+type BubbleSizeOption struct {
+	// MinRadius is the radius for a size of zero. Default is 2.
+	MinRadius float64
+	// MaxRadius is the radius for the largest size across all series. Default is 20.
+	MaxRadius float64
+	// ShowLegend specifies if the size legend with reference bubbles should be rendered on the right of the chart.
+	// Default is true when any series provides Sizes.
+	ShowLegend *bool
+	// LegendValues sets the sizes of the reference bubbles. Default is three values derived from the largest size.
+	LegendValues []float64
+	// ValueFormatter defines how the reference sizes are rendered to strings.
+	ValueFormatter ValueFormatter
+	// FontStyle specifies the font for the size legend labels.
+	FontStyle FontStyle
 }
 
 const defaultSymbolSize = 2.0
 
+// bubbleScale maps size values to a radius so that the bubble area grows linearly with the size.
+type bubbleScale struct {
+	minRadius, maxRadius float64
+	maxSize              float64
+}
+
+// newBubbleScale returns the scale for the series sizes, the maxSize will be zero if no sizes are provided.
+func newBubbleScale(seriesList ScatterSeriesList, opt BubbleSizeOption) bubbleScale {
+	scale := bubbleScale{
+		minRadius: opt.MinRadius,
+		maxRadius: opt.MaxRadius,
+	}
+	if scale.minRadius <= 0 {
+		scale.minRadius = 2
+	}
+	if scale.maxRadius <= 0 {
+		scale.maxRadius = 20
+	}
+	scale.maxRadius = max(scale.maxRadius, scale.minRadius)
+	for _, series := range seriesList {
+		for _, sizes := range series.Sizes {
+			for _, size := range sizes {
+				if isValidExtent(size) && size > scale.maxSize {
+					scale.maxSize = size
+				}
+			}
+		}
+	}
+	return scale
+}
+
+// radius returns the bubble radius for the size, or false if the size is not valid for the scale.
+func (b bubbleScale) radius(size float64) (float64, bool) {
+	if b.maxSize <= 0 || !isValidExtent(size) || size < 0 {
+		return 0, false
+	}
+	minArea := b.minRadius * b.minRadius
+	area := minArea + (b.maxRadius*b.maxRadius-minArea)*min(size/b.maxSize, 1)
+	return math.Sqrt(area), true
+}
+
+// legendValues returns the sizes for the reference bubbles, largest first.
+func (b bubbleScale) legendValues(values []float64) []float64 {
+	if len(values) == 0 {
+		top := niceNum(b.maxSize)
+		if top > b.maxSize*1.1 {
+			// use a smaller nice step so the largest reference stays close to the data
+			top = niceNum(b.maxSize / 2)
+		}
+		values = []float64{top, niceNum(top / 4), niceNum(top / 16)}
+	}
+	result := make([]float64, 0, len(values))
+	for _, v := range values {
+		if _, ok := b.radius(v); ok {
+			result = append(result, v)
+		}
+	}
+	slices.SortFunc(result, func(a, b float64) int {
+		return cmp.Compare(b, a)
+	})
+	return result
+}
+
+// bubbleExtentSeriesList extends the value axis range of a series list by the bubble radii, so bubbles at the edge
+// of the data remain within the plot.
+type bubbleExtentSeriesList struct {
+	seriesList
+	bubbles ScatterSeriesList
+	scale   bubbleScale
+	// axisSize is the estimated pixel size of the value axis, used to convert the radii to data values.
+	axisSize float64
+}
+
+// newBubbleExtentSeriesList returns the series list extended by the radii of the bubbles, or the series list
+// unchanged if no bubble sizes are provided.
+func newBubbleExtentSeriesList(sl seriesList, bubbles ScatterSeriesList, scale bubbleScale,
+	p *Painter, padding Box) seriesList {
+	if scale.maxSize <= 0 {
+		return sl
+	}
+	return bubbleExtentSeriesList{
+		seriesList: sl,
+		bubbles:    bubbles,
+		scale:      scale,
+		// the title, legend, and x-axis are not yet measured, so the estimate is reduced to avoid under padding
+		axisSize: float64(p.Height()-padding.Top-padding.Bottom) * 0.8,
+	}
+}
+
+func (b bubbleExtentSeriesList) extentValues(yAxisIndex int, stacked bool) []float64 {
+	var result []float64
+	if el, ok := b.seriesList.(seriesExtentList); ok {
+		result = el.extentValues(yAxisIndex, stacked)
+	}
+	minVal, maxVal, _ := getSeriesMinMaxSumMax(b.seriesList, yAxisIndex, false)
+	pixels := b.axisSize - 2*b.scale.maxRadius
+	if minVal > maxVal || pixels <= 0 {
+		return result // no data on the axis, or no space to fit the bubbles
+	}
+	// data value per pixel once the range is widened to fit the largest bubbles at both ends
+	perPixel := max(maxVal-minVal, zeroSpanAdjustment) / pixels
+	for _, series := range b.bubbles {
+		if series.YAxisIndex != yAxisIndex {
+			continue
+		}
+		for i, values := range series.Values {
+			for j, v := range values {
+				if !isValidExtent(v) || i >= len(series.Sizes) || j >= len(series.Sizes[i]) {
+					continue
+				}
+				if radius, ok := b.scale.radius(series.Sizes[i][j]); ok {
+					result = append(result, v-radius*perPixel, v+radius*perPixel)
+				}
+			}
+		}
+	}
+	return result
+}
+
 func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 	p := s.p
 	opt := s.opt
@@ -76,10 +219,13 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 
 	markLinePainter := newMarkLinePainter(seriesPainter)
 	trendLinePainter := newTrendLinePainter(seriesPainter)
-	rendererList := []renderer{markLinePainter, trendLinePainter}
+	errorBarPainter := newErrorBarPainter(seriesPainter)
+	rendererList := []renderer{markLinePainter, trendLinePainter, errorBarPainter}
 
 	seriesNames := opt.SeriesList.names()
+	sizeScale := newBubbleScale(opt.SeriesList, opt.BubbleSize)
 	var points []Point
+	var bubbles []scatterBubble
 	for index, series := range opt.SeriesList {
 		seriesSymbol := series.Symbol
 		if seriesSymbol.Shape == "" {
@@ -109,9 +255,17 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 		} else {
 			points = points[:0]
 		}
+		bubbles = bubbles[:0]
+		var errorColor Color
+		var errorStrokeWidth, stdDevError float64
+		var capWidth int
+		if series.ErrorBar.isSet() {
+			errorColor, errorStrokeWidth, capWidth = errorBarStyle(series.ErrorBar, seriesColor, 8)
+			stdDevError = series.ErrorBar.stdDevError(series.getValues())
+		}
 		for i, sampleValues := range series.Values {
 			allNull := true
-			for _, item := range sampleValues {
+			for j, item := range sampleValues {
 				if !isValidExtent(item) {
 					continue
 				}
@@ -120,7 +274,26 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 					X: xValues[i],
 					Y: yRange.getRestHeight(item),
 				}
-				points = append(points, p)
+				if i < len(series.Sizes) && j < len(series.Sizes[i]) {
+					if radius, ok := sizeScale.radius(series.Sizes[i][j]); ok {
+						bubbles = append(bubbles, scatterBubble{point: p, radius: radius})
+					} else {
+						points = append(points, p)
+					}
+				} else {
+					points = append(points, p)
+				}
+
+				if low, high, ok := series.ErrorBar.bounds(i, item, stdDevError); ok {
+					errorBarPainter.add(errorBarRenderOption{
+						center:      p.X,
+						low:         yRange.getRestHeight(low),
+						high:        yRange.getRestHeight(high),
+						capWidth:    capWidth,
+						color:       errorColor,
+						strokeWidth: errorStrokeWidth,
+					})
+				}
 
 				if labelPainter != nil {
 					labelPainter.Add(labelValue{
@@ -147,6 +320,7 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 		default:
 			seriesPainter.Dots(points, seriesColor, seriesColor, 1.0, symbolSize)
 		}
+		s.renderBubbles(seriesPainter, bubbles, seriesSymbol.Shape, seriesColor)
 
 		if len(series.MarkLine.Lines) > 0 {
 			markLinePainter.add(markLineRenderOption{
@@ -176,18 +350,109 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 	if err := doRender(rendererList...); err != nil {
 		return BoxZero, err
 	}
+	if s.sizeLegendWidth > 0 {
+		s.renderSizeLegend(seriesPainter, sizeScale)
+	}
 	return p.box, nil
 }
 
+// scatterBubble is a point with a radius derived from the series Sizes.
+type scatterBubble struct {
+	point  Point
+	radius float64
+}
+
+// renderBubbles draws the sized points largest first so that smaller bubbles remain visible.
+func (s *scatterChart) renderBubbles(p *Painter, bubbles []scatterBubble, shape SymbolShape, color Color) {
+	slices.SortStableFunc(bubbles, func(a, b scatterBubble) int {
+		return cmp.Compare(b.radius, a.radius)
+	})
+	for _, b := range bubbles {
+		points := []Point{b.point}
+		switch shape {
+		case SymbolCircle:
+			p.Dots(points, s.opt.Theme.GetBackgroundColor(), color, 1.0, b.radius)
+		case SymbolSquare:
+			p.squares(points, color.WithAlpha(160), color, 1.0, ceilFloatToInt(b.radius*2.0))
+		case SymbolDiamond:
+			p.diamonds(points, color.WithAlpha(160), color, 1.0, ceilFloatToInt(b.radius*2.8))
+		default:
+			p.Dots(points, color.WithAlpha(160), color, 1.0, b.radius)
+		}
+	}
+}
+
+// sizeLegendValues returns the reference sizes with their labels.
+func (s *scatterChart) sizeLegendValues(scale bubbleScale) ([]float64, []string) {
+	values := scale.legendValues(s.opt.BubbleSize.LegendValues)
+	formatter := getPreferredValueFormatter(s.opt.BubbleSize.ValueFormatter, s.opt.ValueFormatter)
+	labels := make([]string, len(values))
+	for i, v := range values {
+		labels[i] = formatter(v)
+	}
+	return values, labels
+}
+
+// renderSizeLegend draws the reference bubbles stacked vertically, vertically centered on the plot.
+func (s *scatterChart) renderSizeLegend(seriesPainter *Painter, scale bubbleScale) {
+	values, labels := s.sizeLegendValues(scale)
+	if len(values) == 0 {
+		return
+	}
+	fontStyle := fillFontStyleDefaults(s.opt.BubbleSize.FontStyle, defaultLabelFontSize,
+		s.opt.Theme.GetLabelTextColor(), s.p.font)
+	const gap = 6
+	totalHeight := -gap
+	radii := make([]float64, len(values))
+	for i, v := range values {
+		radii[i], _ = scale.radius(v)
+		totalHeight += ceilFloatToInt(radii[i]*2) + gap
+	}
+
+	// the legend is drawn on the root painter, within the padding reserved right of the chart
+	p := s.p
+	maxRadius := ceilFloatToInt(scale.maxRadius)
+	centerX := p.Width() - (s.opt.Padding.Right - s.sizeLegendWidth) - maxRadius - 1
+	y := seriesPainter.box.Top - p.box.Top + (seriesPainter.Height()-totalHeight)/2
+	strokeColor := s.opt.Theme.GetLegendTextColor()
+	for i, label := range labels {
+		diameter := ceilFloatToInt(radii[i] * 2)
+		cy := y + diameter/2
+		p.Circle(radii[i], centerX, cy, ColorTransparent, strokeColor, 1.0)
+		textBox := p.MeasureText(label, 0, fontStyle)
+		p.Text(label, centerX-maxRadius-gap-textBox.Width(), cy+textBox.Height()/2, 0, fontStyle)
+		y += diameter + gap
+	}
+}
+
+// reserveSizeLegend increases the right padding by the space needed for the bubble size legend, if it's shown.
+func (s *scatterChart) reserveSizeLegend(scale bubbleScale) {
+	opt := s.opt
+	if flagIs(false, opt.BubbleSize.ShowLegend) || scale.maxSize <= 0 {
+		return
+	}
+	_, labels := s.sizeLegendValues(scale)
+	if len(labels) == 0 {
+		return
+	}
+	fontStyle := fillFontStyleDefaults(opt.BubbleSize.FontStyle, defaultLabelFontSize,
+		opt.Theme.GetLabelTextColor(), s.p.font)
+	textWidth, _ := s.p.measureTextMaxWidthHeight(labels, 0, fontStyle)
+	s.sizeLegendWidth = textWidth + ceilFloatToInt(scale.maxRadius*2) + 20
+	opt.Padding.Right += s.sizeLegendWidth
+}
+
 func (s *scatterChart) Render() (Box, error) {
 	p := s.p
 	opt := s.opt
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	sizeScale := newBubbleScale(opt.SeriesList, opt.BubbleSize)
 	// boundary gap default must be set here as it's used by the x-axis as well
 	if opt.XAxis.BoundaryGap == nil {
-		opt.XAxis.BoundaryGap = Ptr(false)
+		// bubbles are centered in the category slots, so the first and last bubbles are within the plot
+		opt.XAxis.BoundaryGap = Ptr(sizeScale.maxSize > 0)
 	}
 	if opt.Legend.Symbol == "" {
 		if opt.Symbol.Shape == "" {
@@ -197,11 +462,13 @@ func (s *scatterChart) Render() (Box, error) {
 		}
 	}
 
+	s.reserveSizeLegend(sizeScale)
+
 	// TODO - scatter uses CategoryAxisOption as a faux-category axis for what is semantically value data
 	renderResult, err := defaultRender(p, defaultRenderOption{
 		theme:          opt.Theme,
 		padding:        opt.Padding,
-		seriesList:     opt.SeriesList,
+		seriesList:     newBubbleExtentSeriesList(opt.SeriesList, opt.SeriesList, sizeScale, p, opt.Padding),
 		categoryAxis:   &s.opt.XAxis,
 		valueAxis:      opt.YAxis,
 		title:          opt.Title,
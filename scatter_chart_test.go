@@ -652,6 +652,184 @@ func validateScatterChartRender(t *testing.T, svgP, pngP *Painter, opt ScatterCh
 	assertEqualPNGCRC(t, expectedCRC, rasterData)
 }
 
+func makeBubbleScatterChartOption() ScatterChartOption {
+	opt := NewScatterChartOptionWithSeries(NewSeriesListBubble(
+		[][]float64{
+			{120, 132, 101, 134, 90, 230, 210},
+			{220, 182, 191, 234, 290, 330, 310},
+		},
+		[][]float64{
+			{10, 40, 5, 80, 20, 60, 100},
+			{30, 15, 70, 45, 90, 25, 55},
+		},
+		ScatterSeriesOption{Names: []string{"A", "B"}}))
+	opt.XAxis.Labels = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	opt.XAxis.BoundaryGap = Ptr(true)
+	return opt
+}
+
+func TestNewSeriesListBubble(t *testing.T) {
+	t.Parallel()
+
+	sl := NewSeriesListBubble([][]float64{{1, 2}, {3}}, [][]float64{{10, 20}},
+		ScatterSeriesOption{Names: []string{"a", "b"}})
+
+	require.Len(t, sl, 2)
+	assert.Equal(t, [][]float64{{1}, {2}}, sl[0].Values)
+	assert.Equal(t, [][]float64{{10}, {20}}, sl[0].Sizes)
+	assert.Equal(t, "b", sl[1].Name)
+	assert.Nil(t, sl[1].Sizes)
+}
+
+func TestBubbleScale(t *testing.T) {
+	t.Parallel()
+
+	sl := ScatterSeriesList{
+		{Sizes: [][]float64{{25, GetNullValue()}, {100}}},
+		{Sizes: [][]float64{{50}}},
+	}
+	scale := newBubbleScale(sl, BubbleSizeOption{MinRadius: 0.001, MaxRadius: 10})
+	assert.InDelta(t, 100.0, scale.maxSize, 0)
+
+	radius, ok := scale.radius(100)
+	assert.True(t, ok)
+	assert.InDelta(t, 10.0, radius, 1e-3)
+	radius, ok = scale.radius(25) // quarter area
+	assert.True(t, ok)
+	assert.InDelta(t, 5.0, radius, 1e-3)
+	radius, _ = scale.radius(400) // clamped
+	assert.InDelta(t, 10.0, radius, 1e-3)
+	_, ok = scale.radius(-1)
+	assert.False(t, ok)
+	_, ok = scale.radius(GetNullValue())
+	assert.False(t, ok)
+
+	assert.Equal(t, []float64{100, 25, 10}, scale.legendValues(nil))
+	assert.Equal(t, []float64{80, 20}, scale.legendValues([]float64{20, -5, 80}))
+
+	defaults := newBubbleScale(sl, BubbleSizeOption{})
+	assert.InDelta(t, 2.0, defaults.minRadius, 0)
+	assert.InDelta(t, 20.0, defaults.maxRadius, 0)
+	_, ok = newBubbleScale(ScatterSeriesList{{}}, BubbleSizeOption{}).radius(1)
+	assert.False(t, ok)
+}
+
+func TestScatterChartBubble(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() ScatterChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBubbleScatterChartOption,
+		},
+		{
+			name: "custom_radius_and_legend",
+			makeOptions: func() ScatterChartOption {
+				opt := makeBubbleScatterChartOption()
+				opt.Title.Text = "Bubble"
+				opt.BubbleSize = BubbleSizeOption{
+					MinRadius:    4,
+					MaxRadius:    30,
+					LegendValues: []float64{100, 50},
+					ValueFormatter: func(f float64) string {
+						return FormatValueHumanizeShort(f, 0, false) + " units"
+					},
+					FontStyle: FontStyle{FontSize: 10, FontColor: ColorBlue},
+				}
+				return opt
+			},
+		},
+		{
+			name: "hidden_legend_symbols",
+			makeOptions: func() ScatterChartOption {
+				opt := makeBubbleScatterChartOption()
+				opt.BubbleSize.ShowLegend = Ptr(false)
+				opt.SeriesList[0].Symbol.Shape = SymbolSquare
+				opt.SeriesList[1].Symbol.Shape = SymbolCircle
+				opt.SeriesList[1].Sizes[2] = []float64{GetNullValue()}
+				return opt
+			},
+		},
+		{
+			name: "mixed_with_scatter",
+			makeOptions: func() ScatterChartOption {
+				opt := makeBubbleScatterChartOption()
+				opt.SeriesList[0].Sizes = nil
+				opt.SeriesList[1].Symbol.Shape = SymbolDiamond
+				opt.SeriesList[1].Label.Show = Ptr(true)
+				return opt
+			},
+		},
+		{
+			name: "edge_values",
+			makeOptions: func() ScatterChartOption {
+				return NewScatterChartOptionWithSeries(NewSeriesListBubble(
+					[][]float64{{10, 50, 30, 90, 20}},
+					[][]float64{{100, 10, 20, 100, 100}}))
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.ScatterChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestScatterChartBubbleGeneric(t *testing.T) {
+	t.Parallel()
+
+	seriesList := NewSeriesListGeneric([][]float64{{10, 50, 30, 90, 20}, {40, 60, 70, 20, 50}}, ChartTypeScatter)
+	seriesList[0].Sizes = []float64{100, 10, 20, 100, 100}
+	seriesList[1].Type = ChartTypeLine
+
+	scatter := filterSeriesList[ScatterSeriesList](seriesList, ChartTypeScatter)
+	require.Len(t, scatter, 1)
+	assert.Equal(t, [][]float64{{100}, {10}, {20}, {100}, {100}}, scatter[0].Sizes)
+
+	p, err := Render(ChartOption{
+		OutputFormat: ChartOutputSVG,
+		SeriesList:   seriesList,
+	})
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+}
+
+func TestScatterChartBubbleGenericSizeOption(t *testing.T) {
+	t.Parallel()
+
+	seriesList := NewSeriesListBubble([][]float64{{10, 50, 30, 90, 20}, {40, 60, 70, 20, 50}},
+		[][]float64{{100, 10, 20, 100, 100}, {30, 60, 10, 40, 80}}).ToGenericSeriesList()
+	assert.Equal(t, []float64{100, 10, 20, 100, 100}, seriesList[0].Sizes)
+
+	p, err := Render(ChartOption{
+		OutputFormat: ChartOutputSVG,
+		SeriesList:   seriesList,
+		BubbleSize: BubbleSizeOption{
+			MaxRadius:    12,
+			LegendValues: []float64{50, 100},
+		},
+	})
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+}
+
 func TestScatterChartError(t *testing.T) {
 	t.Parallel()
 
@@ -13,13 +13,17 @@ const (
 	SeriesMarkTypeMin     = "min"
 	SeriesMarkTypeAverage = "average"
 	SeriesMarkTypeMedian  = "median"
+	// SeriesMarkTypeValue marks the fixed Value set on the SeriesMark, only supported for mark lines.
+	SeriesMarkTypeValue = "value"
 )
 
 // SeriesMark describes a single mark line or point type.
 type SeriesMark struct {
-	// Type is the mark data type: "max", "min", "average", "median".
-	// "average" and "median" are only for mark line.
+	// Type is the mark data type: "max", "min", "average", "median", "value".
+	// "average", "median", and "value" are only for mark line.
 	Type string
+	// Value is the fixed value marked by the "value" type.
+	Value float64
 	// Global specifies the mark references the sum of all series. Only used when
 	// the Series is "Stacked" and the mark is on the LAST Series of the SeriesList.
 	Global bool
@@ -79,6 +83,8 @@ type GenericSeries struct {
 	// For ChartTypeCandlestick, the Values field must contain OHLC data encoded as groups of 4 consecutive
 	// float64 values: [Open, High, Low, Close, ...]. For N candlesticks, Values must have exactly N*4 elements.
 	// For ChartTypeViolin, Values encodes mirrored extents as interleaved pairs: [A0, B0, A1, B1, ...].
+	// For ChartTypeBoxPlot, Values encodes the summary [Min, Q1, Median, Q3, Max] followed by any outliers.
+	// For ChartTypeWaterfall, Values encodes interleaved pairs [V0, T0, V1, T1, ...] where T is 1 for total bars.
 	Values []float64
 	// YAxisIndex is the y-axis to apply the series to: must be 0 or 1.
 	YAxisIndex int
@@ -94,6 +100,17 @@ type GenericSeries struct {
 	// MarkLine provides a mark line configuration for this series. When using MarkLine, configure
 	// padding on the chart's right side to ensure space for the values.
 	MarkLine SeriesMarkLine
+	// Sizes optionally provides a size for each value of a ChartTypeScatter series, rendering each point as a bubble
+	// with an area proportional to its size.
+	Sizes []float64
+	// Step overrides the ChartOption Step for a ChartTypeLine series, rendering the line as piecewise constant steps.
+	Step string
+	// ErrorBar provides a configuration for error bars around each value of a ChartTypeLine, ChartTypeBar,
+	// ChartTypeHorizontalBar, or ChartTypeScatter series.
+	ErrorBar SeriesErrorBar
+
+	// labelValues overrides the values rendered in the labels, set to the original values of 100% stacked series.
+	labelValues []float64
 }
 
 func (g *GenericSeries) getYAxisIndex() int {
@@ -101,7 +118,10 @@ func (g *GenericSeries) getYAxisIndex() int {
 }
 
 func (g *GenericSeries) getValues() []float64 {
-	if g.Type != ChartTypeViolin && g.Type != ChartTypeHorizontalViolin {
+	if g.Type == ChartTypeWaterfall {
+		ws := decodeGenericWaterfallSeries(g)
+		return ws.getValues()
+	} else if g.Type != ChartTypeViolin && g.Type != ChartTypeHorizontalViolin {
 		return g.Values
 	}
 	// Generic violin values are encoded pairwise as [A0,B0,A1,B1,...].
@@ -148,6 +168,10 @@ func (g GenericSeriesList) getSeriesLen(index int) int {
 		return len(g[index].Values) // invalid OHLC format, each value will get its own candle
 	case ChartTypeViolin, ChartTypeHorizontalViolin:
 		return len(g[index].Values) / 2
+	case ChartTypeBoxPlot:
+		return 1
+	case ChartTypeWaterfall:
+		return len(g[index].Values) / 2
 	default:
 		return len(g[index].Values)
 	}
@@ -168,6 +192,19 @@ func (g GenericSeriesList) markPointSize() int {
 	return size
 }
 
+// extentValues returns the error bar ranges so they are included in the axis range.
+func (g GenericSeriesList) extentValues(yAxisIndex int, stacked bool) []float64 {
+	var errorBars []SeriesErrorBar
+	var values [][]float64
+	for _, series := range g {
+		if series.YAxisIndex == yAxisIndex {
+			errorBars = append(errorBars, series.ErrorBar)
+			values = append(values, series.Values)
+		}
+	}
+	return errorBarExtents(errorBars, values, stacked && yAxisIndex == 0)
+}
+
 func (g GenericSeriesList) setSeriesName(index int, name string) {
 	g[index].Name = name
 }
@@ -205,9 +242,15 @@ type LineSeries struct {
 	TrendLine []SeriesTrendLine
 	// Symbol specifies a custom shape and size for the series.
 	Symbol Symbol
+	// Step overrides the LineChartOption Step for this series, rendering the line as piecewise constant steps.
+	Step string
+	// ErrorBar provides a configuration for error bars around each value of this series.
+	ErrorBar SeriesErrorBar
 
 	// absThemeIndex represents the series index when combined with other chart types.
 	absThemeIndex *int
+	// labelValues overrides the values rendered in the labels, set to the original values of 100% stacked series.
+	labelValues []float64
 }
 
 func (l *LineSeries) getYAxisIndex() int {
@@ -275,6 +318,19 @@ func (l LineSeriesList) markPointSize() int {
 	return size
 }
 
+// extentValues returns the error bar ranges so they are included in the axis range.
+func (l LineSeriesList) extentValues(yAxisIndex int, stacked bool) []float64 {
+	var errorBars []SeriesErrorBar
+	var values [][]float64
+	for _, series := range l {
+		if series.YAxisIndex == yAxisIndex {
+			errorBars = append(errorBars, series.ErrorBar)
+			values = append(values, series.Values)
+		}
+	}
+	return errorBarExtents(errorBars, values, stacked && yAxisIndex == 0)
+}
+
 func (l LineSeriesList) setSeriesName(index int, name string) {
 	l[index].Name = name
 }
@@ -303,6 +359,8 @@ func (l LineSeriesList) ToGenericSeriesList() GenericSeriesList {
 			Type:       ChartTypeLine,
 			MarkLine:   s.MarkLine,
 			MarkPoint:  s.MarkPoint,
+			Step:       s.Step,
+			ErrorBar:   s.ErrorBar,
 		}
 	}
 	return result
@@ -312,6 +370,9 @@ func (l LineSeriesList) ToGenericSeriesList() GenericSeriesList {
 type ScatterSeries struct {
 	// Values provides the series data values.
 	Values [][]float64
+	// Sizes optionally provides a size dimension matching the shape of Values, rendering each point as a bubble
+	// with an area proportional to its size. Points without a valid size use the Symbol size.
+	Sizes [][]float64
 	// YAxisIndex is the index for the axis, it must be 0 or 1.
 	YAxisIndex int
 	// Label provides the series labels.
@@ -325,6 +386,9 @@ type ScatterSeries struct {
 	TrendLine []SeriesTrendLine
 	// Symbol specifies a custom shape and size for the series.
 	Symbol Symbol
+	// ErrorBar provides a configuration for error bars around each point of this series. The error at an index
+	// applies to each value at that index.
+	ErrorBar SeriesErrorBar
 
 	// absThemeIndex represents the series index when combined with other chart types.
 	absThemeIndex *int
@@ -346,8 +410,16 @@ func (s *ScatterSeries) getValues() []float64 {
 }
 
 func (s *ScatterSeries) avgValues() []float64 {
-	values := make([]float64, len(s.Values))
-	for i, v := range s.Values {
+	return averageScatterValues(s.Values)
+}
+
+// averageScatterValues returns the average of the valid samples at each index, or nil if no values are provided.
+func averageScatterValues(multiValues [][]float64) []float64 {
+	if multiValues == nil {
+		return nil
+	}
+	values := make([]float64, len(multiValues))
+	for i, v := range multiValues {
 		var sum float64
 		var count int
 		for _, x := range v {
@@ -413,6 +485,25 @@ func (s ScatterSeriesList) markPointSize() int {
 	return 0
 }
 
+// extentValues returns the error bar ranges so they are included in the axis range.
+func (s ScatterSeriesList) extentValues(yAxisIndex int, _ bool) []float64 {
+	var result []float64
+	for _, series := range s {
+		if series.YAxisIndex != yAxisIndex || !series.ErrorBar.isSet() {
+			continue
+		}
+		stdDevError := series.ErrorBar.stdDevError(series.getValues())
+		for i, values := range series.Values {
+			for _, v := range values {
+				if low, high, ok := series.ErrorBar.bounds(i, v, stdDevError); ok {
+					result = append(result, low, high)
+				}
+			}
+		}
+	}
+	return result
+}
+
 func (s ScatterSeriesList) setSeriesName(index int, name string) {
 	s[index].Name = name
 }
@@ -440,6 +531,8 @@ func (s ScatterSeriesList) ToGenericSeriesList() GenericSeriesList {
 			Name:       series.Name,
 			Type:       ChartTypeScatter,
 			MarkLine:   series.MarkLine,
+			Sizes:      averageScatterValues(series.Sizes),
+			ErrorBar:   series.ErrorBar,
 		}
 	}
 	return result
@@ -461,11 +554,16 @@ type BarSeries struct {
 	// MarkLine provides a configuration for mark lines for this series. When using a MarkLine, you will want to
 	// configure padding to the chart on the right for the values.
 	MarkLine SeriesMarkLine
+	// ErrorBar provides a configuration for error bars around each value of this series. Error bars on stacked
+	// series are drawn around the end of the stacked bar.
+	ErrorBar SeriesErrorBar
 
 	// absThemeIndex represents the series index when combined with other chart types.
 	absThemeIndex *int
 	// horizontal marks this series as a horizontal bar for correct type reporting.
 	horizontal bool
+	// labelValues overrides the values rendered in the labels, set to the original values of 100% stacked series.
+	labelValues []float64
 }
 
 func (b *BarSeries) getYAxisIndex() int {
@@ -536,6 +634,19 @@ func (b BarSeriesList) markPointSize() int {
 	return size
 }
 
+// extentValues returns the error bar ranges so they are included in the axis range.
+func (b BarSeriesList) extentValues(yAxisIndex int, stacked bool) []float64 {
+	var errorBars []SeriesErrorBar
+	var values [][]float64
+	for _, series := range b {
+		if series.YAxisIndex == yAxisIndex {
+			errorBars = append(errorBars, series.ErrorBar)
+			values = append(values, series.Values)
+		}
+	}
+	return errorBarExtents(errorBars, values, stacked && yAxisIndex == 0)
+}
+
 func (b BarSeriesList) setSeriesName(index int, name string) {
 	b[index].Name = name
 }
@@ -564,6 +675,7 @@ func (b BarSeriesList) ToGenericSeriesList() GenericSeriesList {
 			Type:       s.getType(),
 			MarkLine:   s.MarkLine,
 			MarkPoint:  s.MarkPoint,
+			ErrorBar:   s.ErrorBar,
 		}
 	}
 	return result
@@ -985,6 +1097,13 @@ type seriesList interface {
 	//SetSeriesLabels(label SeriesLabel) // informally included in interface (not used internally in interface)
 }
 
+// seriesExtentList is implemented by series lists which render beyond their series values, such as line bands or
+// error bars. The extent values are included when calculating the value axis range, stacked indicates the series
+// values are accumulated.
+type seriesExtentList interface {
+	extentValues(yAxisIndex int, stacked bool) []float64
+}
+
 // series interface is used to provide the raw series struct to callers of seriesList, allowing direct type checks.
 type series interface {
 	getType() string
@@ -1018,7 +1137,10 @@ func filterSeriesList[T any](sl seriesList, chartType string) T {
 						Name:          v.Name,
 						MarkLine:      v.MarkLine,
 						MarkPoint:     v.MarkPoint,
+						Step:          v.Step,
+						ErrorBar:      v.ErrorBar,
 						absThemeIndex: Ptr(i),
+						labelValues:   v.labelValues,
 					})
 				}
 			}
@@ -1035,10 +1157,12 @@ func filterSeriesList[T any](sl seriesList, chartType string) T {
 				case *GenericSeries:
 					result = append(result, ScatterSeries{
 						Values:        expandSingleValueScatterSeries(v.Values),
+						Sizes:         expandSingleValueScatterSeries(v.Sizes),
 						YAxisIndex:    v.YAxisIndex,
 						Label:         v.Label,
 						Name:          v.Name,
 						MarkLine:      v.MarkLine,
+						ErrorBar:      v.ErrorBar,
 						absThemeIndex: Ptr(i),
 					})
 				}
@@ -1061,7 +1185,9 @@ func filterSeriesList[T any](sl seriesList, chartType string) T {
 						Name:          v.Name,
 						MarkLine:      v.MarkLine,
 						MarkPoint:     v.MarkPoint,
+						ErrorBar:      v.ErrorBar,
 						absThemeIndex: Ptr(i),
+						labelValues:   v.labelValues,
 					})
 				}
 			}
@@ -1084,7 +1210,9 @@ func filterSeriesList[T any](sl seriesList, chartType string) T {
 						Name:          v.Name,
 						MarkLine:      v.MarkLine,
 						MarkPoint:     v.MarkPoint,
+						ErrorBar:      v.ErrorBar,
 						absThemeIndex: Ptr(i),
+						labelValues:   v.labelValues,
 						horizontal:    true,
 					})
 				}
@@ -1245,12 +1373,73 @@ func filterSeriesList[T any](sl seriesList, chartType string) T {
 			}
 		}
 		return any(result).(T)
+	case ChartTypeBoxPlot:
+		result := make(BoxPlotSeriesList, 0, sl.len())
+		for i := 0; i < sl.len(); i++ {
+			s := sl.getSeries(i)
+			if chartTypeMatch(chartType, s.getType()) {
+				switch v := s.(type) {
+				case *BoxPlotSeries:
+					result = append(result, *v)
+				case *GenericSeries:
+					// decode summary: [Min, Q1, Median, Q3, Max, outliers...]
+					bs := BoxPlotSeries{
+						YAxisIndex:    v.YAxisIndex,
+						Name:          v.Name,
+						absThemeIndex: Ptr(i),
+					}
+					if len(v.Values) >= 5 {
+						bs.Min, bs.Q1, bs.Median, bs.Q3, bs.Max = v.Values[0], v.Values[1], v.Values[2], v.Values[3], v.Values[4]
+						bs.Outliers = v.Values[5:]
+					} else { // invalid summary, will not be rendered
+						bs.Min, bs.Q1, bs.Median, bs.Q3, bs.Max =
+							GetNullValue(), GetNullValue(), GetNullValue(), GetNullValue(), GetNullValue()
+					}
+					result = append(result, bs)
+				}
+			}
+		}
+		return any(result).(T)
+	case ChartTypeWaterfall:
+		result := make(WaterfallSeriesList, 0, sl.len())
+		for i := 0; i < sl.len(); i++ {
+			s := sl.getSeries(i)
+			if chartTypeMatch(chartType, s.getType()) {
+				switch v := s.(type) {
+				case *WaterfallSeries:
+					result = append(result, *v)
+				case *GenericSeries:
+					ws := decodeGenericWaterfallSeries(v)
+					ws.absThemeIndex = Ptr(i)
+					result = append(result, ws)
+				}
+			}
+		}
+		return any(result).(T)
 	default:
 		var zero T
 		return zero
 	}
 }
 
+// decodeGenericWaterfallSeries decodes the pairwise [V0, T0, V1, T1, ...] encoding into a WaterfallSeries.
+func decodeGenericWaterfallSeries(g *GenericSeries) WaterfallSeries {
+	ws := WaterfallSeries{
+		Values:     make([]float64, 0, len(g.Values)/2),
+		YAxisIndex: g.YAxisIndex,
+		Label:      g.Label,
+		Name:       g.Name,
+		MarkLine:   g.MarkLine,
+	}
+	for j := 0; j+1 < len(g.Values); j += 2 {
+		if g.Values[j+1] != 0 {
+			ws.TotalIndexes = append(ws.TotalIndexes, len(ws.Values))
+		}
+		ws.Values = append(ws.Values, g.Values[j])
+	}
+	return ws
+}
+
 func chartTypeMatch(expected, actual string) bool {
 	return expected == "" || expected == actual || (expected == ChartTypeLine && actual == "")
 }
@@ -1335,6 +1524,16 @@ func getSeriesMinMaxSumMax(sl seriesList, yaxisIndex int, calcSum bool) (float64
 			}
 		}
 	}
+	if el, ok := sl.(seriesExtentList); ok {
+		for _, item := range el.extentValues(yaxisIndex, calcSum) {
+			if !isValidExtent(item) {
+				continue
+			}
+			minValue = min(minValue, item)
+			maxValue = max(maxValue, item)
+			maxSum = max(maxSum, item)
+		}
+	}
 	// If min was not updated then there were no valid data points. Return
 	// zeros to avoid propagating sentinel values like math.MaxFloat64 which
 	// can corrupt downstream range calculations.
@@ -1390,7 +1589,8 @@ func NewSeriesListLine(values [][]float64, opts ...LineSeriesOption) LineSeriesL
 	return seriesList
 }
 
-// ScatterSeriesOption provides series customization for NewSeriesListScatter and NewSeriesListScatterMultiValue.
+// ScatterSeriesOption provides series customization for NewSeriesListScatter, NewSeriesListScatterMultiValue, and
+// NewSeriesListBubble.
 type ScatterSeriesOption struct {
 	Label     SeriesLabel
 	Names     []string
@@ -1447,6 +1647,19 @@ func NewSeriesListScatterMultiValue(values [][][]float64, opts ...ScatterSeriesO
 	return seriesList
 }
 
+// NewSeriesListBubble builds a SeriesList for a bubble chart, rendered as a ScatterChartOption. The first dimension
+// of the values and sizes indicates the population of the data, while the second dimension provides the samples for
+// the population. Each size is matched to the value at the same position.
+func NewSeriesListBubble(values [][]float64, sizes [][]float64, opts ...ScatterSeriesOption) ScatterSeriesList {
+	seriesList := NewSeriesListScatter(values, opts...)
+	for index := range seriesList {
+		if index < len(sizes) {
+			seriesList[index].Sizes = expandSingleValueScatterSeries(sizes[index])
+		}
+	}
+	return seriesList
+}
+
 // BarSeriesOption provides series customization for NewSeriesListBar.
 type BarSeriesOption struct {
 	Label     SeriesLabel
@@ -2147,6 +2360,339 @@ func NewSeriesListViolin(values [][][2]float64, opts ...ViolinSeriesOption) Viol
 	return seriesList
 }
 
+// BoxPlotSeries references a five-number summary for a single box in a box plot chart.
+type BoxPlotSeries struct {
+	// Min is the lower whisker end.
+	Min float64
+	// Q1 is the first quartile, the lower edge of the box.
+	Q1 float64
+	// Median is the middle value, drawn as a line across the box.
+	Median float64
+	// Q3 is the third quartile, the upper edge of the box.
+	Q3 float64
+	// Max is the upper whisker end.
+	Max float64
+	// Mean is the optional population average (use Ptr(float64)). Rendered when ShowMean is enabled on the chart.
+	Mean *float64
+	// Outliers contains values beyond the whiskers which are rendered as individual points.
+	Outliers []float64
+	// YAxisIndex must be 0 for box plot charts.
+	YAxisIndex int
+	// Name specifies a name for the series.
+	Name string
+
+	// absThemeIndex represents the series index when combined with other chart types.
+	absThemeIndex *int
+}
+
+func (b *BoxPlotSeries) getYAxisIndex() int {
+	return b.YAxisIndex
+}
+
+func (b *BoxPlotSeries) getType() string {
+	return ChartTypeBoxPlot
+}
+
+// getValues returns the summary, outliers and mean for range calculations.
+func (b *BoxPlotSeries) getValues() []float64 {
+	result := make([]float64, 0, 6+len(b.Outliers))
+	result = append(result, b.Min, b.Q1, b.Median, b.Q3, b.Max)
+	result = append(result, b.Outliers...)
+	if b.Mean != nil {
+		result = append(result, *b.Mean)
+	}
+	return result
+}
+
+// isValid returns true if the five-number summary is populated and ordered.
+func (b *BoxPlotSeries) isValid() bool {
+	for _, v := range []float64{b.Min, b.Q1, b.Median, b.Q3, b.Max} {
+		if !isValidExtent(v) {
+			return false
+		}
+	}
+	return b.Min <= b.Q1 && b.Q1 <= b.Median && b.Median <= b.Q3 && b.Q3 <= b.Max
+}
+
+// BoxPlotSeriesList provides the data populations for box plot charts (BoxPlotChartOption).
+type BoxPlotSeriesList []BoxPlotSeries
+
+func (bl BoxPlotSeriesList) names() []string {
+	return seriesNames(bl)
+}
+
+func (bl BoxPlotSeriesList) len() int {
+	return len(bl)
+}
+
+func (bl BoxPlotSeriesList) getSeries(index int) series {
+	return &bl[index]
+}
+
+func (bl BoxPlotSeriesList) getSeriesName(index int) string {
+	return bl[index].Name
+}
+
+func (bl BoxPlotSeriesList) getSeriesValues(index int) []float64 {
+	return bl[index].getValues()
+}
+
+func (bl BoxPlotSeriesList) getSeriesLen(_ int) int {
+	return 1 // each series is a single box
+}
+
+func (bl BoxPlotSeriesList) getSeriesSymbol(_ int) SymbolShape {
+	return ""
+}
+
+func (bl BoxPlotSeriesList) markPointSize() int {
+	return 0
+}
+
+func (bl BoxPlotSeriesList) setSeriesName(index int, name string) {
+	bl[index].Name = name
+}
+
+func (bl BoxPlotSeriesList) sortByNameIndex(dict map[string]int) {
+	slices.SortFunc(bl, func(a, b BoxPlotSeries) int {
+		return cmp.Compare(dict[a.Name], dict[b.Name])
+	})
+}
+
+// ToGenericSeriesList encodes each box as [Min, Q1, Median, Q3, Max] followed by any outliers in GenericSeries Values.
+// The Mean is not retained.
+func (bl BoxPlotSeriesList) ToGenericSeriesList() GenericSeriesList {
+	result := make([]GenericSeries, len(bl))
+	for i, s := range bl {
+		values := make([]float64, 0, 5+len(s.Outliers))
+		values = append(values, s.Min, s.Q1, s.Median, s.Q3, s.Max)
+		values = append(values, s.Outliers...)
+		result[i] = GenericSeries{
+			Values:     values,
+			YAxisIndex: s.YAxisIndex,
+			Name:       s.Name,
+			Type:       ChartTypeBoxPlot,
+		}
+	}
+	return result
+}
+
+// BoxPlotSeriesOption provides series customization for NewSeriesListBoxPlot and NewSeriesListBoxPlotWithSamples.
+type BoxPlotSeriesOption struct {
+	// Names provide data names for each series.
+	Names []string
+	// Whisker specifies how whiskers are computed from samples: BoxPlotWhiskerTukey (default) or
+	// BoxPlotWhiskerMinMax. Only used by NewSeriesListBoxPlotWithSamples.
+	Whisker string
+}
+
+// NewSeriesListBoxPlot builds a BoxPlotSeriesList from precomputed [Min, Q1, Median, Q3, Max] summaries.
+func NewSeriesListBoxPlot(values [][5]float64, opts ...BoxPlotSeriesOption) BoxPlotSeriesList {
+	var opt BoxPlotSeriesOption
+	if len(opts) != 0 {
+		opt = opts[0]
+	}
+
+	seriesList := make([]BoxPlotSeries, len(values))
+	for index, v := range values {
+		s := BoxPlotSeries{
+			Min:    v[0],
+			Q1:     v[1],
+			Median: v[2],
+			Q3:     v[3],
+			Max:    v[4],
+		}
+		if index < len(opt.Names) {
+			s.Name = opt.Names[index]
+		}
+		seriesList[index] = s
+	}
+	return seriesList
+}
+
+// NewSeriesListBoxPlotWithSamples builds a BoxPlotSeriesList by summarizing the raw sample values of each series.
+// Series without valid samples are set to null values and are not rendered.
+func NewSeriesListBoxPlotWithSamples(samples [][]float64, opts ...BoxPlotSeriesOption) BoxPlotSeriesList {
+	var opt BoxPlotSeriesOption
+	if len(opts) != 0 {
+		opt = opts[0]
+	}
+
+	seriesList := make([]BoxPlotSeries, len(samples))
+	for index, v := range samples {
+		s := summarizeBoxPlotSamples(v, opt.Whisker)
+		if index < len(opt.Names) {
+			s.Name = opt.Names[index]
+		}
+		seriesList[index] = s
+	}
+	return seriesList
+}
+
+// WaterfallSeries references a population of incremental changes for waterfall charts. Each bar floats from the
+// running total of the prior values.
+type WaterfallSeries struct {
+	// Values provides the incremental change for each category. Null values leave a gap without changing the
+	// running total.
+	Values []float64
+	// TotalIndexes marks the value indexes rendered as subtotal or total bars, which are drawn from zero to the
+	// running total. The value at a total index is ignored.
+	TotalIndexes []int
+	// YAxisIndex is the index for the axis, it must be 0 or 1.
+	YAxisIndex int
+	// Label provides the series labels. Change bars are labeled with their change and total bars with the
+	// running total.
+	Label SeriesLabel
+	// Name specifies a name for the series.
+	Name string
+	// MarkLine provides a configuration for mark lines for this series, calculated from the running totals.
+	MarkLine SeriesMarkLine
+
+	// absThemeIndex represents the series index when combined with other chart types.
+	absThemeIndex *int
+}
+
+func (w *WaterfallSeries) getYAxisIndex() int {
+	return w.YAxisIndex
+}
+
+func (w *WaterfallSeries) getType() string {
+	return ChartTypeWaterfall
+}
+
+// getValues returns the running totals followed by the zero baseline that change bars start from.
+func (w *WaterfallSeries) getValues() []float64 {
+	return append(w.runningTotals(), 0)
+}
+
+// isTotal returns true if the value index is configured as a subtotal or total bar.
+func (w *WaterfallSeries) isTotal(index int) bool {
+	return slices.Contains(w.TotalIndexes, index)
+}
+
+// runningTotals returns the cumulative total after each value index. Null values carry the prior total.
+func (w *WaterfallSeries) runningTotals() []float64 {
+	result := make([]float64, len(w.Values))
+	var total float64
+	for i, v := range w.Values {
+		if !w.isTotal(i) && isValidExtent(v) {
+			total += v
+		}
+		result[i] = total
+	}
+	return result
+}
+
+// WaterfallSeriesList provides the data populations for waterfall charts (WaterfallChartOption).
+type WaterfallSeriesList []WaterfallSeries
+
+func (wl WaterfallSeriesList) names() []string {
+	return seriesNames(wl)
+}
+
+func (wl WaterfallSeriesList) len() int {
+	return len(wl)
+}
+
+func (wl WaterfallSeriesList) getSeries(index int) series {
+	return &wl[index]
+}
+
+func (wl WaterfallSeriesList) getSeriesName(index int) string {
+	return wl[index].Name
+}
+
+func (wl WaterfallSeriesList) getSeriesValues(index int) []float64 {
+	return wl[index].Values
+}
+
+func (wl WaterfallSeriesList) getSeriesLen(index int) int {
+	return len(wl[index].Values)
+}
+
+func (wl WaterfallSeriesList) getSeriesSymbol(_ int) SymbolShape {
+	return ""
+}
+
+func (wl WaterfallSeriesList) markPointSize() int {
+	return 0
+}
+
+func (wl WaterfallSeriesList) setSeriesName(index int, name string) {
+	wl[index].Name = name
+}
+
+func (wl WaterfallSeriesList) sortByNameIndex(dict map[string]int) {
+	slices.SortFunc(wl, func(a, b WaterfallSeries) int {
+		return cmp.Compare(dict[a.Name], dict[b.Name])
+	})
+}
+
+// SetSeriesLabels sets the label for all elements in the series.
+func (wl WaterfallSeriesList) SetSeriesLabels(label SeriesLabel) {
+	for i := range wl {
+		wl[i].Label = label
+	}
+}
+
+// ToGenericSeriesList encodes each value in GenericSeries Values as a [Value, IsTotal] pair, with IsTotal set
+// to 1 for subtotal and total bars.
+func (wl WaterfallSeriesList) ToGenericSeriesList() GenericSeriesList {
+	result := make([]GenericSeries, len(wl))
+	for i, s := range wl {
+		values := make([]float64, 0, len(s.Values)*2)
+		for j, v := range s.Values {
+			var total float64
+			if s.isTotal(j) {
+				total = 1
+			}
+			values = append(values, v, total)
+		}
+		result[i] = GenericSeries{
+			Values:     values,
+			YAxisIndex: s.YAxisIndex,
+			Label:      s.Label,
+			Name:       s.Name,
+			Type:       ChartTypeWaterfall,
+			MarkLine:   s.MarkLine,
+		}
+	}
+	return result
+}
+
+// WaterfallSeriesOption provides series customization for NewSeriesListWaterfall.
+type WaterfallSeriesOption struct {
+	Label SeriesLabel
+	Names []string
+	// TotalIndexes marks the value indexes rendered as subtotal or total bars for every series.
+	TotalIndexes []int
+	MarkLine     SeriesMarkLine
+}
+
+// NewSeriesListWaterfall builds a SeriesList for a waterfall chart. The first dimension of the values indicates
+// the population of the data, while the second dimension provides the incremental changes for each category.
+func NewSeriesListWaterfall(values [][]float64, opts ...WaterfallSeriesOption) WaterfallSeriesList {
+	var opt WaterfallSeriesOption
+	if len(opts) != 0 {
+		opt = opts[0]
+	}
+
+	seriesList := make([]WaterfallSeries, len(values))
+	for index, v := range values {
+		s := WaterfallSeries{
+			Values:       v,
+			TotalIndexes: opt.TotalIndexes,
+			Label:        opt.Label,
+			MarkLine:     opt.MarkLine,
+		}
+		if index < len(opt.Names) {
+			s.Name = opt.Names[index]
+		}
+		seriesList[index] = s
+	}
+	return seriesList
+}
+
 type PopulationSummary struct {
 	// Max is the maximum value in the series.
 	Max float64
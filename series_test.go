@@ -264,6 +264,27 @@ func TestNewSeriesListViolin(t *testing.T) {
 	assert.Equal(t, NewMarkLine(SeriesMarkTypeAverage), seriesList[1].MarkLine)
 }
 
+func TestGetSeriesMinMaxSumMaxExtents(t *testing.T) {
+	t.Parallel()
+
+	seriesList := lineBandSeriesList{
+		seriesList: NewSeriesListLine([][]float64{{10, 20}, {5, 8}}),
+		bands: []BandSeries{
+			{Lower: []float64{2, GetNullValue()}, Upper: []float64{40, 22}},
+			{Lower: []float64{-50}, Upper: []float64{90}, YAxisIndex: 1},
+		},
+	}
+
+	min, max, maxSum := getSeriesMinMaxSumMax(seriesList, 0, true)
+	assert.InDelta(t, 2.0, min, 0.0)
+	assert.InDelta(t, 40.0, max, 0.0)
+	assert.InDelta(t, 40.0, maxSum, 0.0)
+
+	min, max, _ = getSeriesMinMaxSumMax(seriesList, 1, false)
+	assert.InDelta(t, -50.0, min, 0.0)
+	assert.InDelta(t, 90.0, max, 0.0)
+}
+
 func TestGetSeriesMinMaxSumMaxEmpty(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,310 @@
+package charts
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+)
+
+// SlopeSeries is a single entity in a slope chart, rendered as a line from its start value to its end value.
+type SlopeSeries struct {
+	// Name specifies the entity name, used in the labels at both ends of the line.
+	Name string
+	// Start is the value on the left axis. Null values are not rendered.
+	Start float64
+	// End is the value on the right axis. Null values are not rendered.
+	End float64
+}
+
+// SlopeChartOption defines the options for rendering a slope chart, comparing the change of each entity between
+// two points with a line between two vertical axes. Render the chart using Painter.SlopeChart.
+type SlopeChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// SeriesList provides the entities for the chart, each line using the matching theme series color.
+	SeriesList []SlopeSeries
+	// StartName specifies the title rendered above the left axis, for example "Before".
+	StartName string
+	// EndName specifies the title rendered above the right axis, for example "After".
+	EndName string
+	// Min sets the value at the bottom of the axes. If nil, calculated from the data.
+	Min *float64
+	// Max sets the value at the top of the axes. If nil, calculated from the data.
+	Max *float64
+	// LineStrokeWidth is the stroke width of the lines. Default is 2.
+	LineStrokeWidth float64
+	// SymbolSize is the radius of the symbols at each end of the lines. Default is 4.
+	SymbolSize float64
+	// Label configures the labels at both ends of each line, which default to the name and value. Labels are spread
+	// vertically to avoid overlapping. Set Show to *false to hide.
+	Label SeriesLabel
+	// ValueFormatter defines how float values are rendered to strings for the labels.
+	ValueFormatter ValueFormatter
+}
+
+type slopeChart struct {
+	p   *Painter
+	opt *SlopeChartOption
+}
+
+// newSlopeChart returns a slope chart renderer.
+func newSlopeChart(p *Painter, opt SlopeChartOption) *slopeChart {
+	return &slopeChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewSlopeChartOptionWithData returns an initialized SlopeChartOption with an entity for each pair of start and
+// end values, matched by index.
+func NewSlopeChartOptionWithData(startValues, endValues []float64) SlopeChartOption {
+	seriesList := make([]SlopeSeries, max(len(startValues), len(endValues)))
+	for i := range seriesList {
+		seriesList[i] = SlopeSeries{Start: GetNullValue(), End: GetNullValue()}
+		if i < len(startValues) {
+			seriesList[i].Start = startValues[i]
+		}
+		if i < len(endValues) {
+			seriesList[i].End = endValues[i]
+		}
+	}
+	return SlopeChartOption{
+		SeriesList:     seriesList,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// spreadLabelPositions returns the positions moved apart by at least the gap while keeping their order, and
+// constrained between minPos and maxPos where space allows.
+func spreadLabelPositions(positions []int, gap, minPos, maxPos int) []int {
+	order := make([]int, len(positions))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(positions[a], positions[b])
+	})
+	result := slices.Clone(positions)
+	for i, index := range order {
+		if i == 0 {
+			result[index] = max(result[index], minPos)
+		} else {
+			result[index] = max(result[index], result[order[i-1]]+gap)
+		}
+	}
+	// shift back up from the end when the labels overflow
+	for i := len(order) - 1; i >= 0; i-- {
+		index := order[i]
+		if i == len(order)-1 {
+			result[index] = min(result[index], maxPos)
+		} else {
+			result[index] = min(result[index], result[order[i+1]]-gap)
+		}
+	}
+	return result
+}
+
+// slopeLabel is a label at one end of a slope line.
+type slopeLabel struct {
+	text      string
+	fontStyle FontStyle
+	box       Box
+}
+
+func (s *slopeChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := s.p
+	opt := s.opt
+	seriesPainter := result.seriesPainter
+	if len(opt.SeriesList) == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+
+	minVal, maxVal := math.MaxFloat64, -math.MaxFloat64
+	for _, series := range opt.SeriesList {
+		for _, v := range []float64{series.Start, series.End} {
+			if isValidExtent(v) {
+				minVal, maxVal = min(minVal, v), max(maxVal, v)
+			}
+		}
+	}
+	if minVal > maxVal {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	if opt.Min != nil {
+		minVal = *opt.Min
+	}
+	if opt.Max != nil {
+		maxVal = *opt.Max
+	}
+	if maxVal <= minVal {
+		minVal, maxVal = minVal-1, minVal+1 // center flat data
+	}
+
+	symbolSize := opt.SymbolSize
+	if symbolSize <= 0 {
+		symbolSize = 4
+	}
+	strokeWidth := opt.LineStrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 2
+	}
+	showLabels := !flagIs(false, opt.Label.Show)
+	fontStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize, opt.Theme.GetLabelTextColor(),
+		seriesPainter.font)
+	valueFormatter := getPreferredValueFormatter(opt.Label.ValueFormatter, opt.ValueFormatter)
+
+	// build the labels to size the space beside the axes, the value is placed next to its axis
+	startLabels := make([]*slopeLabel, len(opt.SeriesList))
+	endLabels := make([]*slopeLabel, len(opt.SeriesList))
+	var startWidth, endWidth, labelHeight int
+	makeLabel := func(index int, series SlopeSeries, value float64, isStart bool) *slopeLabel {
+		if !showLabels || !isValidExtent(value) {
+			return nil
+		}
+		label := &slopeLabel{fontStyle: fontStyle}
+		if opt.Label.LabelFormatter != nil {
+			var labelStyle *LabelStyle
+			label.text, labelStyle = opt.Label.LabelFormatter(index, series.Name, value)
+			if labelStyle != nil {
+				label.fontStyle = mergeFontStyles(labelStyle.FontStyle, fontStyle)
+			}
+		} else {
+			label.text = valueFormatter(value)
+			if series.Name != "" && isStart {
+				label.text = series.Name + " " + label.text
+			} else if series.Name != "" {
+				label.text = label.text + " " + series.Name
+			}
+		}
+		if label.text == "" {
+			return nil
+		}
+		label.box = seriesPainter.MeasureText(label.text, 0, label.fontStyle)
+		labelHeight = max(labelHeight, label.box.Height())
+		if isStart {
+			startWidth = max(startWidth, label.box.Width())
+		} else {
+			endWidth = max(endWidth, label.box.Width())
+		}
+		return label
+	}
+	for i, series := range opt.SeriesList {
+		startLabels[i] = makeLabel(i, series, series.Start, true)
+		endLabels[i] = makeLabel(i, series, series.End, false)
+	}
+
+	const labelMargin = 8
+	titleStyle := fillFontStyleDefaults(FontStyle{}, defaultLabelFontSize, opt.Theme.GetXAxisTextColor(),
+		seriesPainter.font)
+	var top int
+	if opt.StartName != "" || opt.EndName != "" {
+		_, titleHeight := seriesPainter.measureTextMaxWidthHeight([]string{opt.StartName, opt.EndName}, 0, titleStyle)
+		top = titleHeight + labelMargin
+	}
+	top += max(labelHeight/2, int(math.Ceil(symbolSize)))
+	bottom := seriesPainter.Height() - max(labelHeight/2, int(math.Ceil(symbolSize)))
+	leftX := startWidth + labelMargin
+	if startWidth == 0 {
+		leftX = int(math.Ceil(symbolSize))
+	}
+	rightX := seriesPainter.Width() - endWidth - labelMargin
+	if endWidth == 0 {
+		rightX = seriesPainter.Width() - int(math.Ceil(symbolSize))
+	}
+	if rightX-leftX < 20 || bottom <= top {
+		return BoxZero, errors.New("insufficient space for slope chart")
+	}
+	valueY := func(v float64) int {
+		return bottom - int(math.Round((v-minVal)/(maxVal-minVal)*float64(bottom-top)))
+	}
+
+	axisColor := opt.Theme.GetYAxisStrokeColor()
+	axisTop, axisBottom := top-labelHeight/2, bottom+labelHeight/2
+	for _, x := range []int{leftX, rightX} {
+		seriesPainter.LineStroke([]Point{{X: x, Y: axisTop}, {X: x, Y: axisBottom}}, axisColor, 1)
+	}
+	if opt.StartName != "" {
+		box := seriesPainter.MeasureText(opt.StartName, 0, titleStyle)
+		seriesPainter.Text(opt.StartName, leftX-box.Width()/2, box.Height(), 0, titleStyle)
+	}
+	if opt.EndName != "" {
+		box := seriesPainter.MeasureText(opt.EndName, 0, titleStyle)
+		seriesPainter.Text(opt.EndName, rightX-box.Width()/2, box.Height(), 0, titleStyle)
+	}
+
+	for i, series := range opt.SeriesList {
+		color := opt.Theme.GetSeriesColor(i)
+		startValid, endValid := isValidExtent(series.Start), isValidExtent(series.End)
+		if startValid && endValid {
+			seriesPainter.LineStroke([]Point{{X: leftX, Y: valueY(series.Start)}, {X: rightX, Y: valueY(series.End)}},
+				color, strokeWidth)
+		}
+		if startValid {
+			seriesPainter.Circle(symbolSize, leftX, valueY(series.Start), color, color, 0)
+		}
+		if endValid {
+			seriesPainter.Circle(symbolSize, rightX, valueY(series.End), color, color, 0)
+		}
+	}
+
+	renderLabels := func(labels []*slopeLabel, values func(SlopeSeries) float64, isStart bool) {
+		var positions []int
+		var placed []*slopeLabel
+		for i, label := range labels {
+			if label != nil {
+				positions = append(positions, valueY(values(opt.SeriesList[i])))
+				placed = append(placed, label)
+			}
+		}
+		positions = spreadLabelPositions(positions, labelHeight+2, top, bottom)
+		for i, label := range placed {
+			x := rightX + labelMargin
+			if isStart {
+				x = leftX - labelMargin - label.box.Width()
+			}
+			seriesPainter.Text(label.text, x, positions[i]+label.box.Height()/2, 0, label.fontStyle)
+		}
+	}
+	renderLabels(startLabels, func(s SlopeSeries) float64 { return s.Start }, true)
+	renderLabels(endLabels, func(s SlopeSeries) float64 { return s.End }, false)
+	return p.box, nil
+}
+
+func (s *slopeChart) Render() (Box, error) {
+	p := s.p
+	opt := s.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeSlope},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title: opt.Title,
+		legend: &LegendOption{
+			Show: Ptr(false),
+		},
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return s.renderChart(renderResult)
+}
@@ -0,0 +1,143 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicSlopeChartOption() SlopeChartOption {
+	opt := NewSlopeChartOptionWithData([]float64{42, 38, 25, 24, 12, 9}, []float64{51, 33, 26, 21, 19, 10})
+	for i, name := range []string{"Chrome", "Safari", "Edge", "Firefox", "Opera", "Other"} {
+		opt.SeriesList[i].Name = name
+	}
+	opt.StartName = "2024"
+	opt.EndName = "2025"
+	return opt
+}
+
+func TestNewSlopeChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewSlopeChartOptionWithData([]float64{1, 2}, []float64{3})
+
+	require.Len(t, opt.SeriesList, 2)
+	assert.InDelta(t, 1.0, opt.SeriesList[0].Start, 0)
+	assert.InDelta(t, 3.0, opt.SeriesList[0].End, 0)
+	assert.InDelta(t, 2.0, opt.SeriesList[1].Start, 0)
+	assert.InDelta(t, GetNullValue(), opt.SeriesList[1].End, 0)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.NotNil(t, opt.Theme)
+	assert.NotNil(t, opt.ValueFormatter)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.SlopeChart(opt))
+}
+
+func TestSpreadLabelPositions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("separated", func(t *testing.T) {
+		assert.Equal(t, []int{10, 50, 30}, spreadLabelPositions([]int{10, 50, 30}, 10, 0, 100))
+	})
+	t.Run("overlapping", func(t *testing.T) {
+		assert.Equal(t, []int{40, 50, 60}, spreadLabelPositions([]int{40, 45, 48}, 10, 0, 100))
+	})
+	t.Run("unsorted_overlapping", func(t *testing.T) {
+		assert.Equal(t, []int{60, 40, 50}, spreadLabelPositions([]int{48, 40, 45}, 10, 0, 100))
+	})
+	t.Run("bounded", func(t *testing.T) {
+		assert.Equal(t, []int{5, 15, 80, 90, 100}, spreadLabelPositions([]int{-5, 2, 95, 98, 100}, 10, 5, 100))
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, spreadLabelPositions(nil, 10, 0, 100))
+	})
+}
+
+func TestSlopeChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() SlopeChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBasicSlopeChartOption,
+		},
+		{
+			name: "title_range_dark",
+			makeOptions: func() SlopeChartOption {
+				opt := makeBasicSlopeChartOption()
+				opt.Theme = GetTheme(ThemeDark)
+				opt.Title.Text = "Browser Share"
+				opt.Min = Ptr(0.0)
+				opt.Max = Ptr(60.0)
+				opt.LineStrokeWidth = 3
+				opt.SymbolSize = 6
+				return opt
+			},
+		},
+		{
+			name: "label_formatter_null",
+			makeOptions: func() SlopeChartOption {
+				opt := makeBasicSlopeChartOption()
+				opt.StartName = ""
+				opt.EndName = ""
+				opt.SeriesList[5].End = GetNullValue()
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					return FormatValueHumanize(val, 0, false) + "%",
+						&LabelStyle{FontStyle: FontStyle{FontColor: opt.Theme.GetSeriesColor(index)}}
+				}
+				return opt
+			},
+		},
+		{
+			name: "no_labels",
+			makeOptions: func() SlopeChartOption {
+				opt := makeBasicSlopeChartOption()
+				opt.Label.Show = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() SlopeChartOption {
+				opt := makeBasicSlopeChartOption()
+				opt.SeriesList = nil
+				return opt
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.SlopeChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestSlopeChartError(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        200,
+		Height:       400,
+	})
+	opt := makeBasicSlopeChartOption()
+	opt.SeriesList[0].Name = "A very long entity name for the labels"
+	err := p.SlopeChart(opt)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "insufficient space for slope chart")
+}
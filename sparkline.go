@@ -0,0 +1,301 @@
+package charts
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// SparklineTypeLine renders the values as a line.
+	SparklineTypeLine = "line"
+	// SparklineTypeBar renders the values as bars from zero.
+	SparklineTypeBar = "bar"
+	// SparklineTypeWinLoss renders equal height bars, above the center for positive values and below for negative.
+	SparklineTypeWinLoss = "winLoss"
+
+	defaultSparklineWidth  = 120
+	defaultSparklineHeight = 30
+)
+
+// SparklineOption defines the options for rendering a sparkline, a small chart without axes, legend, or title
+// intended to be embedded in tables or text. Render the sparkline using SparklineRender, or Painter.SparklineChart to
+// render onto an existing painter.
+type SparklineOption struct {
+	// OutputFormat specifies the output type for SparklineRender: "svg", "png", "jpg".
+	OutputFormat string
+	// Width specifies the image width for SparklineRender. Default is 120.
+	Width int
+	// Height specifies the image height for SparklineRender. Default is 30.
+	Height int
+	// Theme specifies the colors used for the sparkline.
+	Theme ColorPalette
+	// Padding specifies the padding around the sparkline. Default is no padding.
+	Padding Box
+	// Type selects the rendering: SparklineTypeLine (default), SparklineTypeBar, or SparklineTypeWinLoss.
+	Type string
+	// Values provides the data values. Null values leave a gap.
+	Values []float64
+	// Min sets the minimum value of the scale. If nil, calculated from the data.
+	Min *float64
+	// Max sets the maximum value of the scale. If nil, calculated from the data.
+	Max *float64
+	// Color sets the line or positive bar color. Default is the first theme series color.
+	Color Color
+	// NegativeColor sets the color of bars for negative values. Default is the fourth theme series color.
+	NegativeColor Color
+	// StrokeWidth is the width of the line. Default is 1.
+	StrokeWidth float64
+	// ShowFirst highlights the first value.
+	ShowFirst *bool
+	// ShowLast highlights the last value.
+	ShowLast *bool
+	// ShowMin highlights the minimum value.
+	ShowMin *bool
+	// ShowMax highlights the maximum value.
+	ShowMax *bool
+	// FirstColor sets the color of the first value highlight. Default is a darker shade of Color.
+	FirstColor Color
+	// LastColor sets the color of the last value highlight. Default is a darker shade of Color.
+	LastColor Color
+	// MinColor sets the color of the minimum value highlight. Default is the fourth theme series color.
+	MinColor Color
+	// MaxColor sets the color of the maximum value highlight. Default is the second theme series color.
+	MaxColor Color
+	// DotRadius is the radius of the highlight dots on line sparklines. Default is 2.
+	DotRadius float64
+	// NormalRangeLow sets the lower bound of the normal range band, rendered when both bounds are set.
+	NormalRangeLow *float64
+	// NormalRangeHigh sets the upper bound of the normal range band, rendered when both bounds are set.
+	NormalRangeHigh *float64
+	// NormalRangeColor sets the color of the normal range band. Default is the theme split line color.
+	NormalRangeColor Color
+}
+
+// SparklineRender renders a sparkline directly to an image with the provided options.
+func SparklineRender(opt SparklineOption) (*Painter, error) {
+	if opt.OutputFormat == "" {
+		opt.OutputFormat = chartDefaultOutputFormat
+	}
+	if opt.Width <= 0 {
+		opt.Width = defaultSparklineWidth
+	}
+	if opt.Height <= 0 {
+		opt.Height = defaultSparklineHeight
+	}
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: opt.OutputFormat,
+		Width:        opt.Width,
+		Height:       opt.Height,
+		Theme:        opt.Theme,
+	})
+	if err := p.SparklineChart(opt); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type sparklineChart struct {
+	p   *Painter
+	opt *SparklineOption
+}
+
+// newSparklineChart returns a sparkline renderer.
+func newSparklineChart(p *Painter, opt SparklineOption) *sparklineChart {
+	return &sparklineChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// sparklineHighlight is a value index highlighted with a color.
+type sparklineHighlight struct {
+	index int
+	color Color
+}
+
+// sparklineHighlights returns the values to highlight in render order, later entries taking priority.
+func (s *sparklineChart) sparklineHighlights(color Color) []sparklineHighlight {
+	opt := s.opt
+	first, last, minIndex, maxIndex := -1, -1, -1, -1
+	for i, v := range opt.Values {
+		if !isValidExtent(v) {
+			continue
+		}
+		if first == -1 {
+			first = i
+		}
+		last = i
+		if minIndex == -1 || v < opt.Values[minIndex] {
+			minIndex = i
+		}
+		if maxIndex == -1 || v > opt.Values[maxIndex] {
+			maxIndex = i
+		}
+	}
+	if first == -1 {
+		return nil
+	}
+	var result []sparklineHighlight
+	endpointColor := color.WithAdjustHSL(0, 0, -0.2)
+	add := func(show bool, index int, c, defaultColor Color) {
+		if !show {
+			return
+		} else if c.IsZero() {
+			c = defaultColor
+		}
+		result = append(result, sparklineHighlight{index: index, color: c})
+	}
+	add(flagIs(true, opt.ShowFirst), first, opt.FirstColor, endpointColor)
+	add(flagIs(true, opt.ShowLast), last, opt.LastColor, endpointColor)
+	add(flagIs(true, opt.ShowMin), minIndex, opt.MinColor, opt.Theme.GetSeriesColor(3))
+	add(flagIs(true, opt.ShowMax), maxIndex, opt.MaxColor, opt.Theme.GetSeriesColor(1))
+	return result
+}
+
+func (s *sparklineChart) Render() (Box, error) {
+	opt := s.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(s.p.theme)
+	}
+	sparkType := opt.Type
+	if sparkType == "" {
+		sparkType = SparklineTypeLine
+	} else if sparkType != SparklineTypeLine && sparkType != SparklineTypeBar && sparkType != SparklineTypeWinLoss {
+		return BoxZero, fmt.Errorf("unsupported sparkline Type %q", opt.Type)
+	}
+
+	s.p.drawBackground(opt.Theme.GetBackgroundColor())
+	p := s.p
+	if !opt.Padding.IsZero() {
+		p = p.Child(PainterPaddingOption(opt.Padding))
+	}
+	color := opt.Color
+	if color.IsZero() {
+		color = opt.Theme.GetSeriesColor(0)
+	}
+	highlights := s.sparklineHighlights(color)
+
+	// inset the drawing so line strokes and highlight dots are not clipped at the edges
+	strokeWidth := opt.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1
+	}
+	dotRadius := opt.DotRadius
+	if dotRadius <= 0 {
+		dotRadius = 2
+	}
+	var inset int
+	if sparkType == SparklineTypeLine {
+		inset = ceilFloatToInt(strokeWidth / 2)
+		if len(highlights) != 0 {
+			inset = max(inset, ceilFloatToInt(dotRadius))
+		}
+	}
+	left, top := inset, inset
+	width, height := p.Width()-2*inset, p.Height()-2*inset
+	if width <= 0 || height <= 0 || len(opt.Values) == 0 {
+		return s.p.box, nil
+	}
+
+	minVal, maxVal := math.MaxFloat64, -math.MaxFloat64
+	for _, v := range opt.Values {
+		if isValidExtent(v) {
+			minVal, maxVal = min(minVal, v), max(maxVal, v)
+		}
+	}
+	if minVal > maxVal {
+		return s.p.box, nil // no valid values
+	}
+	if sparkType == SparklineTypeBar {
+		minVal, maxVal = min(minVal, 0), max(maxVal, 0)
+	}
+	if opt.Min != nil {
+		minVal = *opt.Min
+	}
+	if opt.Max != nil {
+		maxVal = *opt.Max
+	}
+	if maxVal <= minVal {
+		minVal, maxVal = minVal-1, minVal+1 // center flat data
+	}
+	valueY := func(v float64) int {
+		ratio := (min(max(v, minVal), maxVal) - minVal) / (maxVal - minVal)
+		return top + height - int(math.Round(ratio*float64(height)))
+	}
+
+	if opt.NormalRangeLow != nil && opt.NormalRangeHigh != nil && sparkType != SparklineTypeWinLoss {
+		bandColor := opt.NormalRangeColor
+		if bandColor.IsZero() {
+			bandColor = opt.Theme.GetAxisSplitLineColor()
+		}
+		y1, y2 := valueY(*opt.NormalRangeHigh), valueY(*opt.NormalRangeLow)
+		if y2 > y1 {
+			p.FilledRect(0, y1, p.Width(), y2, bandColor, bandColor, 0)
+		}
+	}
+
+	if sparkType == SparklineTypeLine {
+		points := make([]Point, len(opt.Values))
+		for i, v := range opt.Values {
+			x := left + width/2
+			if len(opt.Values) > 1 {
+				x = left + int(math.Round(float64(i*width)/float64(len(opt.Values)-1)))
+			}
+			points[i] = Point{X: x, Y: math.MaxInt32}
+			if isValidExtent(v) {
+				points[i].Y = valueY(v)
+			}
+		}
+		p.LineStroke(points, color, strokeWidth)
+		for _, h := range highlights {
+			p.Circle(dotRadius, points[h.index].X, points[h.index].Y, h.color, h.color, 0)
+		}
+		return s.p.box, nil
+	}
+
+	negativeColor := opt.NegativeColor
+	if negativeColor.IsZero() {
+		negativeColor = opt.Theme.GetSeriesColor(3)
+	}
+	slotWidth := float64(width) / float64(len(opt.Values))
+	gap := 0
+	if slotWidth >= 3 {
+		gap = max(1, int(slotWidth*0.2))
+	}
+	baseline := valueY(min(max(0, minVal), maxVal))
+	mid := top + height/2
+	for i, v := range opt.Values {
+		if !isValidExtent(v) || (v == 0 && sparkType == SparklineTypeWinLoss) {
+			continue
+		}
+		x1 := left + int(math.Round(float64(i)*slotWidth))
+		x2 := left + int(math.Round(float64(i+1)*slotWidth)) - gap
+		x2 = max(x2, x1+1)
+		barColor := color
+		if v < 0 {
+			barColor = negativeColor
+		}
+		for _, h := range highlights {
+			if h.index == i {
+				barColor = h.color
+			}
+		}
+		var y1, y2 int
+		if sparkType == SparklineTypeWinLoss {
+			if v > 0 {
+				y1, y2 = top, mid-1
+			} else {
+				y1, y2 = mid+1, top+height
+			}
+		} else {
+			y := valueY(v)
+			y1, y2 = min(y, baseline), max(y, baseline)
+			if y1 == y2 {
+				y2++ // keep values at the baseline visible
+			}
+		}
+		p.FilledRect(x1, y1, x2, y2, barColor, barColor, 0)
+	}
+	return s.p.box, nil
+}
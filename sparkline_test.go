@@ -0,0 +1,120 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sparklineTestValues = []float64{4, 7, 5, 9, 3, 6, 8, 2, 5, 7, 10, 6}
+
+func TestSparklineRender(t *testing.T) {
+	t.Parallel()
+
+	p, err := SparklineRender(SparklineOption{
+		OutputFormat: ChartOutputSVG,
+		Values:       sparklineTestValues,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, defaultSparklineWidth, p.Width())
+	assert.Equal(t, defaultSparklineHeight, p.Height())
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+
+	_, err = SparklineRender(SparklineOption{Type: "area", Values: sparklineTestValues})
+	assert.ErrorContains(t, err, `unsupported sparkline Type "area"`)
+}
+
+func TestSparklineChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		option SparklineOption
+	}{
+		{
+			name: "line_highlights",
+			option: SparklineOption{
+				Values:    sparklineTestValues,
+				ShowFirst: Ptr(true),
+				ShowLast:  Ptr(true),
+				ShowMin:   Ptr(true),
+				ShowMax:   Ptr(true),
+			},
+		},
+		{
+			name: "line_normal_range",
+			option: SparklineOption{
+				Values:          []float64{4, 7, GetNullValue(), 9, 3, 6, 8},
+				StrokeWidth:     2,
+				NormalRangeLow:  Ptr(4.0),
+				NormalRangeHigh: Ptr(7.0),
+				ShowLast:        Ptr(true),
+				LastColor:       ColorRed,
+				DotRadius:       3,
+			},
+		},
+		{
+			name: "bar",
+			option: SparklineOption{
+				Type:    SparklineTypeBar,
+				Values:  []float64{3, -2, 5, 0, -4, 6, 1, GetNullValue(), 2},
+				ShowMax: Ptr(true),
+			},
+		},
+		{
+			name: "bar_custom",
+			option: SparklineOption{
+				Type:            SparklineTypeBar,
+				Theme:           GetTheme(ThemeDark),
+				Padding:         NewBoxEqual(2),
+				Values:          sparklineTestValues,
+				Color:           ColorGreen,
+				Min:             Ptr(0.0),
+				Max:             Ptr(12.0),
+				NormalRangeLow:  Ptr(4.0),
+				NormalRangeHigh: Ptr(8.0),
+				ShowMin:         Ptr(true),
+				MinColor:        ColorRed,
+			},
+		},
+		{
+			name: "win_loss",
+			option: SparklineOption{
+				Type:          SparklineTypeWinLoss,
+				Values:        []float64{1, 1, -1, 1, 0, -3, 2, 1, -1, -1, 4},
+				NegativeColor: ColorBlack,
+			},
+		},
+		{
+			name: "flat",
+			option: SparklineOption{
+				Values: []float64{5, 5, 5, 5},
+			},
+		},
+		{
+			name: "no_data",
+			option: SparklineOption{
+				Values:    []float64{GetNullValue()},
+				ShowFirst: Ptr(true),
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        160,
+				Height:       40,
+			})
+			require.NoError(t, p.SparklineChart(tt.option))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
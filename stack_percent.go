@@ -0,0 +1,206 @@
+package charts
+
+import (
+	"slices"
+)
+
+// stackPercentMax is the value axis max of 100% stacked charts.
+const stackPercentMax = 100.0
+
+// stackPercentFormatter formats a percentage share for the value axis and labels of 100% stacked charts.
+func stackPercentFormatter(f float64) string {
+	return FormatValueHumanize(f, 0, false) + "%"
+}
+
+// stackPercentValues returns the values of the series on the first y-axis scaled so the values at each index sum to
+// 100. Negative values are treated as zero, and null values are retained. Series on other y-axes are not stacked, and
+// have a nil entry.
+func stackPercentValues(sl seriesList) [][]float64 {
+	totals := stackPercentTotals(sl)
+	result := make([][]float64, sl.len())
+	sums := make([]float64, len(totals))
+	lastIndexes := make([]int, len(totals)) // series index of the last value stacked at each index
+	for i := 0; i < sl.len(); i++ {
+		series := sl.getSeries(i)
+		if series.getYAxisIndex() != 0 {
+			continue
+		}
+		values := slices.Clone(series.getValues())
+		for j, v := range values {
+			if !isValidExtent(v) {
+				continue
+			} else if v > 0 {
+				values[j] = v / totals[j] * stackPercentMax
+			} else {
+				values[j] = 0
+			}
+			sums[j] += values[j]
+			lastIndexes[j] = i
+		}
+		result[i] = values
+	}
+	// rounding errors must not extend the stack beyond the fixed axis max
+	for j, sum := range sums {
+		if sum > stackPercentMax {
+			result[lastIndexes[j]][j] -= sum - stackPercentMax
+		}
+	}
+	return result
+}
+
+// stackPercentTotals returns the sum of the positive values of the series on the first y-axis at each index.
+func stackPercentTotals(sl seriesList) []float64 {
+	totals := make([]float64, getSeriesMaxDataCount(sl))
+	for i := 0; i < sl.len(); i++ {
+		series := sl.getSeries(i)
+		if series.getYAxisIndex() != 0 {
+			continue
+		}
+		for j, v := range series.getValues() {
+			if isValidExtent(v) && v > 0 {
+				totals[j] += v
+			}
+		}
+	}
+	return totals
+}
+
+// stackPercentErrorBar returns the error bar with the errors scaled by the same factor as the values at each index,
+// so they remain relative to the percentage share. The errors are limited to the 0-100% range of the stack, given the
+// offset of the prior stacked series, so they are returned as asymmetric Lower and Upper errors. An error derived from
+// the standard deviation is calculated from the original values.
+func stackPercentErrorBar(errorBar SeriesErrorBar, values, shares, offsets, totals []float64) SeriesErrorBar {
+	if !errorBar.isSet() {
+		return errorBar
+	}
+	stdDevError := errorBar.stdDevError(values)
+	lower := make([]float64, len(shares))
+	upper := make([]float64, len(shares))
+	for j, share := range shares {
+		low, high, ok := errorBar.errorsAt(j, stdDevError)
+		if !ok || !isValidExtent(share) || totals[j] <= 0 {
+			lower[j], upper[j] = GetNullValue(), GetNullValue()
+			continue
+		}
+		top := offsets[j] + share
+		lower[j] = min(low/totals[j]*stackPercentMax, top)
+		upper[j] = min(high/totals[j]*stackPercentMax, stackPercentMax-top)
+	}
+	errorBar.Errors = nil
+	errorBar.Lower = lower
+	errorBar.Upper = upper
+	errorBar.StdDevMultiplier = 0
+	return errorBar
+}
+
+// stackPercentOffsets adds the valid shares to the stacked offsets at each index.
+func stackPercentOffsets(offsets, shares []float64) {
+	for j, share := range shares {
+		if isValidExtent(share) {
+			offsets[j] += share
+		}
+	}
+}
+
+// stackPercentLabel returns the series label updated to default to the percentage share when rawLabels is false.
+func stackPercentLabel(label SeriesLabel, rawLabels bool) SeriesLabel {
+	if !rawLabels && label.ValueFormatter == nil && label.LabelFormatter == nil {
+		label.ValueFormatter = stackPercentFormatter
+	}
+	return label
+}
+
+// stackPercentValueAxis returns the value axis fixed to the 0-100% range of a 100% stacked chart.
+func stackPercentValueAxis(axis ValueAxisOption) ValueAxisOption {
+	axis.Min = Ptr(0.0)
+	axis.Max = Ptr(stackPercentMax)
+	if axis.ValueFormatter == nil {
+		axis.ValueFormatter = stackPercentFormatter
+	}
+	if axis.LabelCount == 0 && axis.Unit == 0 && len(axis.Labels) == 0 {
+		axis.LabelCount = 6 // 20% intervals
+	}
+	return axis
+}
+
+// labelValueAt returns the value to render in the label at the index, preferring the label values when set.
+func labelValueAt(labelValues []float64, index int, value float64) float64 {
+	if index < len(labelValues) {
+		return labelValues[index]
+	}
+	return value
+}
+
+// stackPercent returns a copy of the series list with the values on the first y-axis scaled to a percentage share of
+// each index. When rawLabels is true the original values are retained for the labels.
+func (b BarSeriesList) stackPercent(rawLabels bool) BarSeriesList {
+	result := slices.Clone(b)
+	totals := stackPercentTotals(b)
+	offsets := make([]float64, len(totals))
+	for i, values := range stackPercentValues(b) {
+		if values == nil {
+			continue
+		} else if rawLabels {
+			result[i].labelValues = result[i].Values
+		}
+		result[i].ErrorBar = stackPercentErrorBar(result[i].ErrorBar, result[i].Values, values, offsets, totals)
+		stackPercentOffsets(offsets, values)
+		result[i].Values = values
+		result[i].Label = stackPercentLabel(result[i].Label, rawLabels)
+	}
+	return result
+}
+
+// stackPercent returns a copy of the series list with the values on the first y-axis scaled to a percentage share of
+// each index. When rawLabels is true the original values are retained for the labels.
+func (l LineSeriesList) stackPercent(rawLabels bool) LineSeriesList {
+	result := slices.Clone(l)
+	totals := stackPercentTotals(l)
+	offsets := make([]float64, len(totals))
+	for i, values := range stackPercentValues(l) {
+		if values == nil {
+			continue
+		} else if rawLabels {
+			result[i].labelValues = result[i].Values
+		}
+		result[i].ErrorBar = stackPercentErrorBar(result[i].ErrorBar, result[i].Values, values, offsets, totals)
+		stackPercentOffsets(offsets, values)
+		result[i].Values = values
+		result[i].Label = stackPercentLabel(result[i].Label, rawLabels)
+	}
+	return result
+}
+
+// stackPercent returns a copy of the series list with the bar, horizontal bar, and line values on the first y-axis
+// scaled to a percentage share of each index. Each chart type is stacked separately, matching how they are rendered.
+// Other chart types are not stacked and remain unchanged. When rawLabels is true the original values are retained for
+// the labels.
+func (g GenericSeriesList) stackPercent(rawLabels bool) GenericSeriesList {
+	result := slices.Clone(g)
+	for _, chartType := range []string{ChartTypeLine, ChartTypeBar, ChartTypeHorizontalBar} {
+		var stacked GenericSeriesList
+		var stackedIndexes []int
+		for i, series := range g {
+			if chartTypeMatch(chartType, series.Type) {
+				stacked = append(stacked, series)
+				stackedIndexes = append(stackedIndexes, i)
+			}
+		}
+		totals := stackPercentTotals(stacked)
+		offsets := make([]float64, len(totals))
+		for i, values := range stackPercentValues(stacked) {
+			if values == nil {
+				continue
+			}
+			series := &result[stackedIndexes[i]]
+			if rawLabels {
+				series.labelValues = series.Values
+			}
+			series.ErrorBar = stackPercentErrorBar(series.ErrorBar, series.Values, values, offsets, totals)
+			stackPercentOffsets(offsets, values)
+			series.Values = values
+			series.Label = stackPercentLabel(series.Label, rawLabels)
+		}
+	}
+	return result
+}
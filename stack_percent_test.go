@@ -0,0 +1,235 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackPercentValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shares", func(t *testing.T) {
+		series := NewSeriesListBar([][]float64{
+			{1, 2, GetNullValue(), 0},
+			{3, 2, 5},
+			{-4, 4, 15, 0},
+		})
+		result := stackPercentValues(series)
+		require.Len(t, result, 3)
+		assert.InDeltaSlice(t, []float64{25, 25}, result[0][:2], 0.000001)
+		assert.False(t, isValidExtent(result[0][2]))
+		assert.InDelta(t, 0.0, result[0][3], 0)
+		assert.InDeltaSlice(t, []float64{75, 25, 25}, result[1], 0.000001)
+		assert.InDeltaSlice(t, []float64{0, 50, 75, 0}, result[2], 0.000001)
+		assert.InDelta(t, 1.0, series[0].Values[0], 0) // input not modified
+	})
+	t.Run("secondary_axis", func(t *testing.T) {
+		series := NewSeriesListLine([][]float64{{1, 1}, {3, 1}, {50, 60}})
+		series[2].YAxisIndex = 1
+		result := stackPercentValues(series)
+		assert.InDeltaSlice(t, []float64{25, 50}, result[0], 0.000001)
+		assert.InDeltaSlice(t, []float64{75, 50}, result[1], 0.000001)
+		assert.Nil(t, result[2])
+	})
+	t.Run("sum_within_max", func(t *testing.T) {
+		values := make([][]float64, 7)
+		for i := range values {
+			values[i] = []float64{0.1 * float64(i+1), 1.0 / 3, 7}
+		}
+		result := stackPercentValues(NewSeriesListBar(values))
+		for j := range values[0] {
+			var sum float64
+			for i := range result {
+				sum += result[i][j]
+			}
+			assert.LessOrEqual(t, sum, stackPercentMax, "index %d", j)
+			assert.InDelta(t, stackPercentMax, sum, 0.000001, "index %d", j)
+		}
+	})
+}
+
+func TestStackPercentSeriesList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("share_labels", func(t *testing.T) {
+		series := NewSeriesListBar([][]float64{{1, 3}, {3, 1}})
+		series[1].Label.ValueFormatter = defaultValueFormatter
+		result := series.stackPercent(false)
+		assert.InDeltaSlice(t, []float64{25, 75}, result[0].Values, 0.000001)
+		assert.Nil(t, result[0].labelValues)
+		require.NotNil(t, result[0].Label.ValueFormatter)
+		assert.Equal(t, "25%", result[0].Label.ValueFormatter(25))
+		assert.Equal(t, "75", result[1].Label.ValueFormatter(75)) // configured formatter retained
+		assert.Equal(t, []float64{1, 3}, series[0].Values)
+	})
+	t.Run("raw_labels", func(t *testing.T) {
+		series := NewSeriesListLine([][]float64{{1, 3}, {3, 1}})
+		result := series.stackPercent(true)
+		assert.InDeltaSlice(t, []float64{75, 25}, result[1].Values, 0.000001)
+		assert.Equal(t, []float64{3, 1}, result[1].labelValues)
+		assert.Nil(t, result[1].Label.ValueFormatter)
+		assert.InDelta(t, 3.0, labelValueAt(result[1].labelValues, 0, result[1].Values[0]), 0)
+	})
+	t.Run("generic_mixed_types", func(t *testing.T) {
+		series := NewSeriesListGeneric([][]float64{{1, 3}, {3, 1}, {5, 5}, {1, 3}}, ChartTypeBar)
+		series[1].Type = ChartTypeLine
+		series[2].Type = ChartTypeScatter
+		series[3].Type = "" // rendered as a line
+		result := series.stackPercent(true)
+		assert.InDeltaSlice(t, []float64{100, 100}, result[0].Values, 0.000001) // only bar series
+		assert.InDeltaSlice(t, []float64{75, 25}, result[1].Values, 0.000001)
+		assert.Equal(t, []float64{3, 1}, result[1].labelValues)
+		assert.Equal(t, []float64{5, 5}, result[2].Values)
+		assert.Nil(t, result[2].labelValues)
+		assert.InDeltaSlice(t, []float64{25, 75}, result[3].Values, 0.000001)
+	})
+	t.Run("error_bars", func(t *testing.T) {
+		series := NewSeriesListBar([][]float64{{1, 3}, {3, 1}, {0, 0}})
+		series[0].ErrorBar = SeriesErrorBar{Errors: []float64{0.4, 0.8}}
+		series[1].ErrorBar = SeriesErrorBar{StdDevMultiplier: 1}
+		series[2].ErrorBar = SeriesErrorBar{Lower: []float64{1}, Upper: []float64{2, GetNullValue()}}
+		result := series.stackPercent(false)
+		assert.InDeltaSlice(t, []float64{10, 20}, result[0].ErrorBar.Lower, 0.000001)
+		assert.InDeltaSlice(t, []float64{10, 20}, result[0].ErrorBar.Upper, 0.000001)
+		assert.Equal(t, []float64{0.4, 0.8}, series[0].ErrorBar.Errors)
+		// the standard deviation of the original values, limited to the top of the stack
+		assert.InDeltaSlice(t, []float64{25, 25}, result[1].ErrorBar.Lower, 0.000001)
+		assert.InDeltaSlice(t, []float64{0, 0}, result[1].ErrorBar.Upper, 0.000001)
+		assert.Zero(t, result[1].ErrorBar.StdDevMultiplier)
+		assert.InDelta(t, 25.0, result[2].ErrorBar.Lower[0], 0.000001)
+		assert.False(t, isValidExtent(result[2].ErrorBar.Lower[1]))
+
+		generic := NewSeriesListGeneric([][]float64{{2, 6}, {6, 2}}, ChartTypeLine)
+		generic[0].ErrorBar = SeriesErrorBar{Errors: []float64{4, 4}}
+		assert.InDeltaSlice(t, []float64{25, 50}, generic.stackPercent(false)[0].ErrorBar.Lower, 0.000001)
+	})
+}
+
+func TestStackPercentValueAxis(t *testing.T) {
+	t.Parallel()
+
+	axis := stackPercentValueAxis(ValueAxisOption{})
+	require.NotNil(t, axis.Min)
+	require.NotNil(t, axis.Max)
+	assert.InDelta(t, 0.0, *axis.Min, 0)
+	assert.InDelta(t, 100.0, *axis.Max, 0)
+	assert.Equal(t, 6, axis.LabelCount)
+	assert.Equal(t, "40%", axis.ValueFormatter(40))
+
+	axis = stackPercentValueAxis(ValueAxisOption{Max: Ptr(50.0), Unit: 25, ValueFormatter: defaultValueFormatter})
+	assert.InDelta(t, 100.0, *axis.Max, 0)
+	assert.Equal(t, 0, axis.LabelCount)
+	assert.Equal(t, "40", axis.ValueFormatter(40))
+}
+
+func TestStackPercentChart(t *testing.T) {
+	t.Parallel()
+
+	labels := []string{"A", "B", "C", "D", "E"}
+	values := [][]float64{
+		{120, 200, 150, 80, 70},
+		{60, 100, 50, 120, 30},
+		{20, 300, 100, 50, 10},
+	}
+
+	tests := []struct {
+		name   string
+		render func(*Painter) error
+	}{
+		{
+			name: "bar",
+			render: func(p *Painter) error {
+				opt := NewBarChartOptionWithData(values)
+				opt.CategoryAxis.Labels = labels
+				opt.StackPercent = Ptr(true)
+				opt.SeriesList.SetSeriesLabels(SeriesLabel{Show: Ptr(true)})
+				return p.BarChart(opt)
+			},
+		},
+		{
+			name: "bar_raw_labels",
+			render: func(p *Painter) error {
+				opt := NewBarChartOptionWithData(values)
+				opt.CategoryAxis.Labels = labels
+				opt.StackPercent = Ptr(true)
+				opt.StackPercentRawLabels = Ptr(true)
+				opt.SeriesList.SetSeriesLabels(SeriesLabel{Show: Ptr(true)})
+				return p.BarChart(opt)
+			},
+		},
+		{
+			name: "horizontal_bar",
+			render: func(p *Painter) error {
+				opt := NewBarChartOptionWithData(values)
+				opt.Horizontal = true
+				opt.CategoryAxis.Labels = labels
+				opt.StackPercent = Ptr(true)
+				opt.SeriesList.SetSeriesLabels(SeriesLabel{Show: Ptr(true)})
+				return p.BarChart(opt)
+			},
+		},
+		{
+			name: "line",
+			render: func(p *Painter) error {
+				opt := NewLineChartOptionWithData(values)
+				opt.XAxis.Labels = labels
+				opt.StackPercent = Ptr(true)
+				opt.StackPercentRawLabels = Ptr(true)
+				opt.SeriesList.SetSeriesLabels(SeriesLabel{Show: Ptr(true)})
+				return p.LineChart(opt)
+			},
+		},
+		{
+			name: "bar_error_bars",
+			render: func(p *Painter) error {
+				opt := NewBarChartOptionWithData(values)
+				opt.CategoryAxis.Labels = labels
+				opt.StackPercent = Ptr(true)
+				opt.SeriesList[0].ErrorBar = SeriesErrorBar{Errors: []float64{20, 30, 25, 10, 15}}
+				opt.SeriesList[1].ErrorBar = SeriesErrorBar{StdDevMultiplier: 0.5}
+				return p.BarChart(opt)
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, tt.render(p))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestStackPercentChartOption(t *testing.T) {
+	t.Parallel()
+
+	seriesList := NewSeriesListGeneric([][]float64{
+		{120, 200, 150, 80, 70},
+		{60, 100, 50, 120, 30},
+		{20, 300, 100, 50, 10},
+	}, ChartTypeHorizontalBar)
+	seriesList.SetSeriesLabels(SeriesLabel{Show: Ptr(true)})
+	opt := ChartOption{
+		OutputFormat: ChartOutputSVG,
+		SeriesList:   seriesList,
+		StackPercent: Ptr(true),
+		YAxis:        []YAxisOption{{Labels: []string{"A", "B", "C", "D", "E"}}},
+	}
+
+	p, err := Render(opt)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+	assert.Equal(t, []float64{120, 200, 150, 80, 70}, opt.SeriesList[0].Values)
+}
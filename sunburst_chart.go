@@ -0,0 +1,299 @@
+package charts
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// SunburstLabelRadial rotates labels to read outward along the radius of each arc.
+	SunburstLabelRadial = "radial"
+	// SunburstLabelTangential rotates labels to follow the curve of each arc.
+	SunburstLabelTangential = "tangential"
+)
+
+// SunburstNode is a node in the hierarchy rendered by a sunburst chart. Leaf nodes provide a Value, while the value
+// of a parent node is the sum of its children.
+type SunburstNode = TreemapNode
+
+// SunburstChartOption defines the options for rendering a sunburst chart. Render the chart using
+// Painter.SunburstChart.
+type SunburstChartOption struct {
+	// Theme specifies the colors used for the chart. Each top-level node is assigned a series color.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Nodes provides the top-level nodes of the hierarchy, rendered as the innermost ring.
+	Nodes []SunburstNode
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the legend of top-level nodes.
+	Legend LegendOption
+	// RadiusRing sets the outer radius of the outermost ring, for example "40%". Default is "48%".
+	RadiusRing string
+	// RadiusCenter is the radius for the center hole and must be smaller than RadiusRing. Default is a quarter
+	// of RadiusRing.
+	RadiusCenter string
+	// MaxDepth limits how many rings are rendered, deeper nodes are aggregated into their ancestor.
+	// Default renders all levels.
+	MaxDepth int
+	// SegmentGap provides a margin between each arc.
+	SegmentGap float64
+	// LabelRotation specifies the label orientation: SunburstLabelRadial (default) or SunburstLabelTangential.
+	// Labels which do not fit within their arc are hidden.
+	LabelRotation string
+	// Label configures the arc labels, which default to the node name. The LabelFormatter index is the index of
+	// the top-level node.
+	Label SeriesLabel
+}
+
+type sunburstChart struct {
+	p   *Painter
+	opt *SunburstChartOption
+}
+
+// newSunburstChart returns a sunburst chart renderer.
+func newSunburstChart(p *Painter, opt SunburstChartOption) *sunburstChart {
+	return &sunburstChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewSunburstChartOptionWithData returns an initialized SunburstChartOption with the provided top-level nodes.
+func NewSunburstChartOptionWithData(nodes []SunburstNode) SunburstChartOption {
+	return SunburstChartOption{
+		Nodes:   nodes,
+		Padding: defaultPadding,
+		Theme:   GetDefaultTheme(),
+	}
+}
+
+// sunburstArc is a node positioned within a ring of the sunburst.
+type sunburstArc struct {
+	sector
+	depth      int
+	groupIndex int
+	name       string
+}
+
+// sunburstDepth returns the number of levels in the hierarchy, limited to maxDepth when set.
+func sunburstDepth(nodes []SunburstNode, maxDepth int) int {
+	var depth int
+	for _, node := range nodes {
+		depth = max(depth, 1+sunburstDepth(node.Children, 0))
+	}
+	if maxDepth > 0 {
+		return min(depth, maxDepth)
+	}
+	return depth
+}
+
+// sunburstArcs positions each node as an arc, with children spanning the angle of their parent. The offset is
+// the value preceding the nodes around the full circle, used with the root total to derive the angles.
+func (s *sunburstChart) sunburstArcs(nodes []SunburstNode, values []float64, offset, total float64,
+	depth, depthCount, groupIndex int, innerRadius, ringWidth float64, groupColor Color) ([]sunburstArc, error) {
+	var arcs []sunburstArc
+	for i, node := range nodes {
+		if values[i] <= 0 {
+			continue
+		}
+		nodeGroupIndex, color := groupIndex, groupColor
+		if depth == 0 {
+			nodeGroupIndex = i
+			color = s.opt.Theme.GetSeriesColor(i)
+		} else {
+			color = groupColor.WithAdjustHSL(0, 0, 0.1)
+		}
+		outerRadius := innerRadius + ringWidth*float64(depth+1)
+		arcs = append(arcs, sunburstArc{
+			sector: newSector(outerRadius, nodeGroupIndex, values[i], offset, total,
+				node.Name, SeriesLabel{Show: Ptr(false)}, color),
+			depth:      depth,
+			groupIndex: nodeGroupIndex,
+			name:       node.Name,
+		})
+		if len(node.Children) != 0 && depth+1 < depthCount {
+			childValues, err := treemapValues(node.Children)
+			if err != nil {
+				return nil, err
+			}
+			childArcs, err := s.sunburstArcs(node.Children, childValues, offset, total,
+				depth+1, depthCount, nodeGroupIndex, innerRadius, ringWidth, color)
+			if err != nil {
+				return nil, err
+			}
+			arcs = append(arcs, childArcs...)
+		}
+		offset += values[i]
+	}
+	return arcs, nil
+}
+
+// renderLabel draws the arc label centered within the ring when it fits.
+func (s *sunburstChart) renderLabel(p *Painter, arc sunburstArc, cx, cy int, ringWidth float64) {
+	opt := s.opt
+	var text string
+	fontStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize,
+		treemapTextColor(arc.color), p.font)
+	if opt.Label.LabelFormatter != nil {
+		var labelStyle *LabelStyle
+		text, labelStyle = opt.Label.LabelFormatter(arc.groupIndex, arc.name, arc.value)
+		if labelStyle != nil {
+			fontStyle = mergeFontStyles(labelStyle.FontStyle, fontStyle)
+		}
+	} else if opt.Label.ValueFormatter != nil {
+		text = opt.Label.ValueFormatter(arc.value)
+	} else {
+		text = arc.name
+	}
+	if text == "" {
+		return
+	}
+
+	textBox := p.MeasureText(text, 0, fontStyle)
+	width, height := float64(textBox.Width()), float64(textBox.Height())
+	midRadius := arc.radius - ringWidth/2
+	arcLength := arc.delta * midRadius
+	midAngle, radians := arc.midAngle, 0.0
+	if arc.delta >= 2*math.Pi-1e-9 {
+		// a full ring has room for a horizontal label at the top
+		if width > arcLength*0.9 || height > ringWidth-2 {
+			return
+		}
+		midAngle = -math.Pi / 2
+	} else if opt.LabelRotation == SunburstLabelTangential {
+		if width > arcLength*0.9 || height > ringWidth-2 {
+			return
+		}
+		radians = arc.midAngle + math.Pi/2
+	} else {
+		if width > ringWidth-4 || height > arcLength {
+			return
+		}
+		radians = arc.midAngle
+	}
+	if math.Cos(radians) < 0 {
+		radians += math.Pi // flip to keep the text upright
+	}
+
+	// offset from the arc center to the text baseline start, rotating with the text
+	midX := float64(cx) + midRadius*math.Cos(midAngle)
+	midY := float64(cy) + midRadius*math.Sin(midAngle)
+	cos, sin := math.Cos(radians), math.Sin(radians)
+	x := midX - cos*width/2 - sin*height/2
+	y := midY - sin*width/2 + cos*height/2
+	p.Text(text, int(math.Round(x)), int(math.Round(y)), radians, fontStyle)
+}
+
+func (s *sunburstChart) renderChart(result *defaultRenderResult) (Box, error) {
+	opt := s.opt
+	values, err := treemapValues(opt.Nodes)
+	if err != nil {
+		return BoxZero, err
+	}
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	if total <= 0 {
+		result.renderNoData(opt.Theme)
+		return s.p.box, nil
+	}
+
+	seriesPainter := result.seriesPainter
+	cx, cy, diameter := circleChartPosition(seriesPainter)
+	radiusRing := getFlexibleRadius(diameter, 0.48, opt.RadiusRing)
+	radiusCenter := radiusRing / 4
+	if opt.RadiusCenter != "" {
+		radiusCenter, err = parseFlexibleValue(opt.RadiusCenter, diameter)
+		if err != nil {
+			return BoxZero, fmt.Errorf("invalid RadiusCenter: %w", err)
+		}
+		radiusCenter = max(min(radiusCenter, radiusRing-10), 0)
+	}
+	depthCount := sunburstDepth(opt.Nodes, opt.MaxDepth)
+	ringWidth := (radiusRing - radiusCenter) / float64(depthCount)
+
+	arcs, err := s.sunburstArcs(opt.Nodes, values, 0, total, 0, depthCount, 0, radiusCenter, ringWidth, Color{})
+	if err != nil {
+		return BoxZero, err
+	}
+
+	// draw the outer rings first, each inner ring covers the center of the prior ring
+	backgroundColor := opt.Theme.GetBackgroundColor()
+	for depth := depthCount - 1; depth >= 0; depth-- {
+		for _, arc := range arcs {
+			if arc.depth != depth {
+				continue
+			}
+			if arc.delta >= 2*math.Pi-1e-9 {
+				// a full arc has matching start and end points, draw as a circle instead
+				seriesPainter.Circle(arc.radius, cx, cy, arc.color, backgroundColor, opt.SegmentGap)
+				continue
+			}
+			seriesPainter.moveTo(cx, cy)
+			seriesPainter.arcTo(cx, cy, arc.radius, arc.radius, arc.startAngle, arc.delta)
+			seriesPainter.lineTo(cx, cy)
+			seriesPainter.close()
+			if opt.SegmentGap > 0 {
+				seriesPainter.fillStroke(arc.color, backgroundColor, opt.SegmentGap)
+			} else {
+				seriesPainter.fill(arc.color)
+			}
+		}
+	}
+
+	// draw the center hole
+	if radiusCenter > 0 {
+		circleColor := backgroundColor
+		if circleColor.IsZero() {
+			circleColor = ColorWhite
+		} else if circleColor.A != 255 {
+			circleColor = circleColor.WithAlpha(255)
+		}
+		seriesPainter.Circle(radiusCenter, cx, cy, circleColor, circleColor, 0.0)
+	}
+
+	if !flagIs(false, opt.Label.Show) {
+		for _, arc := range arcs {
+			s.renderLabel(seriesPainter, arc, cx, cy, ringWidth)
+		}
+	}
+	return s.p.box, nil
+}
+
+func (s *sunburstChart) Render() (Box, error) {
+	p := s.p
+	opt := s.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+
+	names := make([]string, len(opt.Nodes))
+	for i, node := range opt.Nodes {
+		names[i] = node.Name
+	}
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeSunburst, seriesNames: names},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title:  opt.Title,
+		legend: &opt.Legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return s.renderChart(renderResult)
+}
@@ -0,0 +1,141 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSunburstChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewSunburstChartOptionWithData(makeBasicTreemapNodes())
+
+	assert.Len(t, opt.Nodes, 5)
+	assert.Equal(t, defaultPadding, opt.Padding)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.SunburstChart(opt))
+}
+
+func TestSunburstDepth(t *testing.T) {
+	t.Parallel()
+
+	nodes := makeBasicTreemapNodes()
+	assert.Equal(t, 3, sunburstDepth(nodes, 0))
+	assert.Equal(t, 1, sunburstDepth(nodes, 1))
+	assert.Equal(t, 2, sunburstDepth(nodes, 2))
+	assert.Equal(t, 3, sunburstDepth(nodes, 5))
+	assert.Equal(t, 0, sunburstDepth(nil, 0))
+}
+
+func TestSunburstChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() SunburstChartOption
+	}{
+		{
+			name: "basic",
+			makeOptions: func() SunburstChartOption {
+				return NewSunburstChartOptionWithData(makeBasicTreemapNodes())
+			},
+		},
+		{
+			name: "tangential_labels_dark_theme",
+			makeOptions: func() SunburstChartOption {
+				opt := NewSunburstChartOptionWithData(makeBasicTreemapNodes())
+				opt.LabelRotation = SunburstLabelTangential
+				opt.Theme = GetTheme(ThemeVividDark)
+				return opt
+			},
+		},
+		{
+			name: "radius_segment_gap",
+			makeOptions: func() SunburstChartOption {
+				opt := NewSunburstChartOptionWithData(makeBasicTreemapNodes())
+				opt.Title = TitleOption{Text: "Cost by team"}
+				opt.Legend.Show = Ptr(false)
+				opt.RadiusRing = "160"
+				opt.RadiusCenter = "0"
+				opt.SegmentGap = 2
+				return opt
+			},
+		},
+		{
+			name: "max_depth",
+			makeOptions: func() SunburstChartOption {
+				opt := NewSunburstChartOptionWithData(makeBasicTreemapNodes())
+				opt.MaxDepth = 2
+				return opt
+			},
+		},
+		{
+			name: "label_formatter",
+			makeOptions: func() SunburstChartOption {
+				opt := NewSunburstChartOptionWithData(makeBasicTreemapNodes())
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					return strconv.Itoa(index) + ":" + strconv.Itoa(int(val)), &LabelStyle{
+						FontStyle: FontStyle{FontColor: ColorBlack},
+					}
+				}
+				return opt
+			},
+		},
+		{
+			name: "hidden_labels",
+			makeOptions: func() SunburstChartOption {
+				opt := NewSunburstChartOptionWithData(makeBasicTreemapNodes())
+				opt.Label.Show = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "single_root",
+			makeOptions: func() SunburstChartOption {
+				return NewSunburstChartOptionWithData([]SunburstNode{
+					{Name: "all", Children: []SunburstNode{{Name: "a", Value: 1}, {Name: "b", Value: 2}}},
+				})
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() SunburstChartOption {
+				return NewSunburstChartOptionWithData([]SunburstNode{{Name: "empty"}})
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.SunburstChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestSunburstChartError(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	})
+	err := p.SunburstChart(NewSunburstChartOptionWithData([]SunburstNode{
+		{Name: "a", Value: 4},
+		{Name: "b", Value: -2},
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported negative value")
+}
@@ -0,0 +1,436 @@
+package charts
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+const defaultTreemapDepthPadding = 3
+
+// TreemapNode is a node in the hierarchy rendered by a treemap chart. Leaf nodes provide a Value, while the value
+// of a parent node is the sum of its children.
+type TreemapNode struct {
+	// Name is the node name, rendered as the leaf label or group header.
+	Name string
+	// Value is the size of a leaf node. Ignored when the node has children.
+	Value float64
+	// Children provides the nested nodes, making this node a group.
+	Children []TreemapNode
+}
+
+// TreemapChartOption defines the options for rendering a treemap chart. Render the chart using Painter.TreemapChart.
+type TreemapChartOption struct {
+	// Theme specifies the colors used for the chart. Each top-level node is assigned a series color.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// Nodes provides the top-level nodes of the hierarchy.
+	Nodes []TreemapNode
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the legend of top-level nodes. The legend is only shown when Show is *true.
+	Legend LegendOption
+	// MaxDepth limits how many levels of the hierarchy are rendered, with deeper nodes aggregated into their
+	// ancestor. Default renders all levels.
+	MaxDepth int
+	// DepthPadding specifies the pixel inset between a group and its children for each depth, the last value is
+	// used for deeper levels. Default is 3.
+	DepthPadding []int
+	// ShowHeader when set to *false hides the group name headers rendered above nested nodes.
+	ShowHeader *bool
+	// HeaderFontStyle specifies the font for group headers.
+	HeaderFontStyle FontStyle
+	// Label configures the leaf labels, which default to the node name and value. The LabelFormatter index
+	// is the index of the top-level node.
+	Label SeriesLabel
+	// ValueFormatter defines how float values are rendered to strings for the default labels.
+	ValueFormatter ValueFormatter
+}
+
+type treemapChart struct {
+	p   *Painter
+	opt *TreemapChartOption
+}
+
+// newTreemapChart returns a treemap chart renderer.
+func newTreemapChart(p *Painter, opt TreemapChartOption) *treemapChart {
+	return &treemapChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewTreemapChartOptionWithData returns an initialized TreemapChartOption with the provided top-level nodes.
+func NewTreemapChartOptionWithData(nodes []TreemapNode) TreemapChartOption {
+	return TreemapChartOption{
+		Nodes:          nodes,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// treemapRect is a floating point rectangle used during layout to avoid accumulating rounding error.
+type treemapRect struct {
+	x, y, w, h float64
+}
+
+// treemapValues returns the total value of each node, summing the children of group nodes.
+func treemapValues(nodes []TreemapNode) ([]float64, error) {
+	values := make([]float64, len(nodes))
+	for i, node := range nodes {
+		if len(node.Children) == 0 {
+			if node.Value < 0 {
+				return nil, fmt.Errorf("unsupported negative value for treemap node %q", node.Name)
+			} else if isValidExtent(node.Value) {
+				values[i] = node.Value
+			}
+			continue
+		}
+		childValues, err := treemapValues(node.Children)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range childValues {
+			values[i] += v
+		}
+	}
+	return values, nil
+}
+
+// squarifyTreemap lays out the values within the rect using the squarified algorithm, which places rows of
+// descending values along the shorter side to keep the aspect ratio of each cell close to 1. A rect is returned
+// for each value in the provided order, with zero values receiving an empty rect.
+func squarifyTreemap(values []float64, rect treemapRect) []treemapRect {
+	result := make([]treemapRect, len(values))
+	var total float64
+	order := make([]int, 0, len(values))
+	for i, v := range values {
+		if v > 0 {
+			total += v
+			order = append(order, i)
+		}
+	}
+	if total <= 0 || rect.w <= 0 || rect.h <= 0 {
+		return result
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if values[a] > values[b] {
+			return -1
+		} else if values[a] < values[b] {
+			return 1
+		}
+		return 0
+	})
+	scale := rect.w * rect.h / total
+	areas := make([]float64, len(order))
+	for i, index := range order {
+		areas[i] = values[index] * scale
+	}
+
+	for start := 0; start < len(areas); {
+		side := min(rect.w, rect.h)
+		end := start + 1
+		worst := squarifyWorstRatio(areas[start:end], side)
+		for end < len(areas) {
+			next := squarifyWorstRatio(areas[start:end+1], side)
+			if next > worst {
+				break
+			}
+			worst = next
+			end++
+		}
+
+		var rowArea float64
+		for _, a := range areas[start:end] {
+			rowArea += a
+		}
+		if rect.w >= rect.h { // row is placed as a column on the left
+			thickness := rowArea / rect.h
+			y := rect.y
+			for i := start; i < end; i++ {
+				h := areas[i] / thickness
+				result[order[i]] = treemapRect{x: rect.x, y: y, w: thickness, h: h}
+				y += h
+			}
+			rect.x += thickness
+			rect.w -= thickness
+		} else { // row is placed across the top
+			thickness := rowArea / rect.w
+			x := rect.x
+			for i := start; i < end; i++ {
+				w := areas[i] / thickness
+				result[order[i]] = treemapRect{x: x, y: rect.y, w: w, h: thickness}
+				x += w
+			}
+			rect.y += thickness
+			rect.h -= thickness
+		}
+		start = end
+	}
+	return result
+}
+
+// squarifyWorstRatio returns the worst aspect ratio of a row of areas laid along a side of the given length.
+func squarifyWorstRatio(row []float64, side float64) float64 {
+	var sum, maxArea float64
+	minArea := math.MaxFloat64
+	for _, a := range row {
+		sum += a
+		maxArea = max(maxArea, a)
+		minArea = min(minArea, a)
+	}
+	sideSq := side * side
+	sumSq := sum * sum
+	return max(sideSq*maxArea/sumSq, sumSq/(sideSq*minArea))
+}
+
+// depthPadding returns the configured inset for the given depth.
+func (t *treemapChart) depthPadding(depth int) int {
+	padding := t.opt.DepthPadding
+	if len(padding) == 0 {
+		return defaultTreemapDepthPadding
+	} else if depth < len(padding) {
+		return padding[depth]
+	}
+	return padding[len(padding)-1]
+}
+
+// renderNodes lays out the nodes within the rect and draws each node, recursing into groups.
+func (t *treemapChart) renderNodes(p *Painter, nodes []TreemapNode, values []float64, rect treemapRect,
+	depth int, groupIndex int, groupColor Color) error {
+	opt := t.opt
+	for i, r := range squarifyTreemap(values, rect) {
+		if r.w <= 0 || r.h <= 0 {
+			continue
+		}
+		node := nodes[i]
+		nodeGroupIndex, color := groupIndex, groupColor
+		if depth == 0 {
+			nodeGroupIndex = i
+			color = opt.Theme.GetSeriesColor(i)
+		} else {
+			color = groupColor.WithAdjustHSL(0, 0, 0.08)
+		}
+		left, top := int(math.Round(r.x)), int(math.Round(r.y))
+		right, bottom := int(math.Round(r.x+r.w)), int(math.Round(r.y+r.h))
+		p.FilledRect(left, top, right, bottom, color, opt.Theme.GetBackgroundColor(), 1)
+
+		if len(node.Children) != 0 && (opt.MaxDepth <= 0 || depth+1 < opt.MaxDepth) {
+			padding := t.depthPadding(depth)
+			inner := treemapRect{
+				x: r.x + float64(padding),
+				y: r.y + float64(padding),
+				w: r.w - float64(2*padding),
+				h: r.h - float64(2*padding),
+			}
+			if !flagIs(false, opt.ShowHeader) {
+				headerHeight := t.renderHeader(p, node.Name, depth, left, top+padding, right-left, color)
+				inner.y += float64(headerHeight)
+				inner.h -= float64(headerHeight)
+			}
+			if inner.w >= 1 && inner.h >= 1 {
+				childValues, err := treemapValues(node.Children)
+				if err != nil {
+					return err
+				}
+				if err := t.renderNodes(p, node.Children, childValues, inner, depth+1,
+					nodeGroupIndex, color); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		t.renderLabel(p, node.Name, values[i], nodeGroupIndex, left, top, right-left, bottom-top, color)
+	}
+	return nil
+}
+
+// renderHeader draws the group name at the top of the group, returning the height reserved for the header.
+func (t *treemapChart) renderHeader(p *Painter, name string, depth, x, y, width int, fillColor Color) int {
+	fontStyle := fillFontStyleDefaults(t.opt.HeaderFontStyle, defaultLabelFontSize,
+		treemapTextColor(fillColor), p.font)
+	textBox := p.MeasureText(name, 0, fontStyle)
+	if name == "" || textBox.Width()+8 > width {
+		return 0
+	}
+	p.Text(name, x+4, y+textBox.Height(), 0, fontStyle)
+	return textBox.Height() + t.depthPadding(depth)
+}
+
+// renderLabel draws the leaf label within the cell when it fits.
+func (t *treemapChart) renderLabel(p *Painter, name string, value float64, groupIndex int,
+	x, y, width, height int, fillColor Color) {
+	opt := t.opt
+	if flagIs(false, opt.Label.Show) {
+		return
+	}
+	const inset = 4
+	width -= inset * 2
+	height -= inset * 2
+	if width <= 0 || height <= 0 {
+		return
+	}
+	fontStyle := fillFontStyleDefaults(opt.Label.FontStyle, defaultLabelFontSize,
+		treemapTextColor(fillColor), p.font)
+	var texts []string
+	if opt.Label.LabelFormatter != nil {
+		text, labelStyle := opt.Label.LabelFormatter(groupIndex, name, value)
+		if labelStyle != nil {
+			fontStyle = mergeFontStyles(labelStyle.FontStyle, fontStyle)
+		}
+		texts = []string{text}
+	} else {
+		valueFormatter := getPreferredValueFormatter(opt.Label.ValueFormatter, opt.ValueFormatter)
+		// fall back to just the name when the value does not fit
+		texts = []string{name + "\n" + valueFormatter(value), name}
+	}
+	for _, text := range texts {
+		if text == "" {
+			continue
+		}
+		// words are not broken across lines, so each word must fit within the cell
+		wordsFit := !slices.ContainsFunc(strings.Fields(text), func(word string) bool {
+			return p.MeasureText(word, 0, fontStyle).Width() >= width
+		})
+		if !wordsFit {
+			continue
+		}
+		box := p.measureTextFit(text, width, fontStyle)
+		if box.Width() <= width && box.Height() <= height {
+			p.TextFit(text, x+inset, y+inset+int(fontStyle.FontSize), width, fontStyle)
+			return
+		}
+	}
+}
+
+// treemapTextColor returns a font color readable on the provided fill color.
+func treemapTextColor(fillColor Color) Color {
+	if isLightColor(fillColor) {
+		return defaultLightFontColor
+	}
+	return defaultDarkFontColor
+}
+
+func (t *treemapChart) renderChart(result *defaultRenderResult) (Box, error) {
+	opt := t.opt
+	values, err := treemapValues(opt.Nodes)
+	if err != nil {
+		return BoxZero, err
+	}
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	if total <= 0 {
+		result.renderNoData(opt.Theme)
+		return t.p.box, nil
+	}
+
+	seriesPainter := result.seriesPainter
+	rect := treemapRect{w: float64(seriesPainter.Width()), h: float64(seriesPainter.Height())}
+	if err := t.renderNodes(seriesPainter, opt.Nodes, values, rect, 0, 0, Color{}); err != nil {
+		return BoxZero, err
+	}
+	return t.p.box, nil
+}
+
+func (t *treemapChart) Render() (Box, error) {
+	p := t.p
+	opt := t.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		opt.Legend.Symbol = SymbolSquare
+	}
+	legend := opt.Legend
+	if legend.Show == nil {
+		legend.Show = Ptr(false) // group headers already label the top-level nodes
+	}
+
+	names := make([]string, len(opt.Nodes))
+	for i, node := range opt.Nodes {
+		names[i] = node.Name
+	}
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:      opt.Theme,
+		padding:    opt.Padding,
+		seriesList: hierarchyFakeSeries{chartType: ChartTypeTreemap, seriesNames: names},
+		categoryAxis: &CategoryAxisOption{
+			Show: Ptr(false),
+		},
+		valueAxis: []ValueAxisOption{
+			{
+				Show: Ptr(false),
+			},
+		},
+		title:  opt.Title,
+		legend: &legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return t.renderChart(renderResult)
+}
+
+// hierarchyFakeSeries is a dummy series type used by hierarchical and flow charts to satisfy defaultRender,
+// providing the top-level node names for the legend.
+type hierarchyFakeSeries struct {
+	chartType   string
+	seriesNames []string
+}
+
+func (h hierarchyFakeSeries) len() int {
+	return len(h.seriesNames)
+}
+
+func (h hierarchyFakeSeries) getSeries(_ int) series {
+	return h
+}
+
+func (h hierarchyFakeSeries) getSeriesName(index int) string {
+	return h.seriesNames[index]
+}
+
+func (h hierarchyFakeSeries) getSeriesValues(_ int) []float64 {
+	return nil // not used, there is no value axis
+}
+
+func (h hierarchyFakeSeries) getSeriesLen(_ int) int {
+	return 0 // not used, there is no category axis
+}
+
+func (h hierarchyFakeSeries) names() []string {
+	return h.seriesNames
+}
+
+func (h hierarchyFakeSeries) markPointSize() int {
+	return 0
+}
+
+func (h hierarchyFakeSeries) setSeriesName(_ int, _ string) {
+	// ignored, node names are used for the legend
+}
+
+func (h hierarchyFakeSeries) sortByNameIndex(_ map[string]int) {
+	// no-op, colors are assigned by node order
+}
+
+func (h hierarchyFakeSeries) getSeriesSymbol(_ int) SymbolShape {
+	return ""
+}
+
+func (h hierarchyFakeSeries) getType() string {
+	return h.chartType
+}
+
+func (h hierarchyFakeSeries) getYAxisIndex() int {
+	return 0
+}
+
+func (h hierarchyFakeSeries) getValues() []float64 {
+	return nil // not used, there is no value axis
+}
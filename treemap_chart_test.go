@@ -0,0 +1,198 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicTreemapNodes() []TreemapNode {
+	return []TreemapNode{
+		{Name: "Engineering", Children: []TreemapNode{
+			{Name: "Platform", Children: []TreemapNode{
+				{Name: "Compute", Value: 120},
+				{Name: "Storage", Value: 60},
+				{Name: "Network", Value: 25},
+			}},
+			{Name: "Mobile", Value: 70},
+			{Name: "Web", Value: 55},
+		}},
+		{Name: "Sales", Children: []TreemapNode{
+			{Name: "EMEA", Value: 40},
+			{Name: "Americas", Value: 65},
+			{Name: "APAC", Value: 20},
+		}},
+		{Name: "Marketing", Children: []TreemapNode{
+			{Name: "Ads", Value: 30},
+			{Name: "Events", Value: 12},
+		}},
+		{Name: "Operations", Value: 18},
+		{Name: "Legal", Value: 6},
+	}
+}
+
+func TestNewTreemapChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewTreemapChartOptionWithData(makeBasicTreemapNodes())
+
+	assert.Len(t, opt.Nodes, 5)
+	assert.Equal(t, defaultPadding, opt.Padding)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.TreemapChart(opt))
+}
+
+func TestTreemapValues(t *testing.T) {
+	t.Parallel()
+
+	values, err := treemapValues(makeBasicTreemapNodes())
+	require.NoError(t, err)
+	assert.Equal(t, []float64{330, 125, 42, 18, 6}, values)
+
+	values, err = treemapValues([]TreemapNode{{Name: "a", Value: GetNullValue()}, {Name: "b", Value: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0, 2}, values)
+
+	_, err = treemapValues([]TreemapNode{{Name: "a", Children: []TreemapNode{{Name: "b", Value: -1}}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unsupported negative value for treemap node "b"`)
+}
+
+func TestSquarifyTreemap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("areas", func(t *testing.T) {
+		values := []float64{1, 6, 2, 4, 3, 6, 2}
+		rect := treemapRect{x: 10, y: 20, w: 600, h: 400}
+		rects := squarifyTreemap(values, rect)
+		require.Len(t, rects, len(values))
+
+		scale := rect.w * rect.h / 24
+		var area float64
+		for i, r := range rects {
+			assert.InDelta(t, values[i]*scale, r.w*r.h, 1e-6)
+			assert.GreaterOrEqual(t, r.x, rect.x-1e-9)
+			assert.GreaterOrEqual(t, r.y, rect.y-1e-9)
+			assert.LessOrEqual(t, r.x+r.w, rect.x+rect.w+1e-9)
+			assert.LessOrEqual(t, r.y+r.h, rect.y+rect.h+1e-9)
+			area += r.w * r.h
+		}
+		assert.InDelta(t, rect.w*rect.h, area, 1e-6)
+	})
+	t.Run("squarified", func(t *testing.T) {
+		// the classic example from Bruls et al. lays out to cells no worse than 3:1
+		rects := squarifyTreemap([]float64{6, 6, 4, 3, 2, 2, 1}, treemapRect{w: 6, h: 4})
+		for _, r := range rects {
+			assert.LessOrEqual(t, max(r.w/r.h, r.h/r.w), 3.0)
+		}
+		assert.InDelta(t, 0.0, rects[0].x, 1e-9)
+		assert.InDelta(t, 3.0, rects[0].w, 1e-9)
+		assert.InDelta(t, 2.0, rects[0].h, 1e-9)
+	})
+	t.Run("zero_values", func(t *testing.T) {
+		rects := squarifyTreemap([]float64{0, 5, -1}, treemapRect{w: 10, h: 10})
+		assert.Equal(t, treemapRect{}, rects[0])
+		assert.Equal(t, treemapRect{w: 10, h: 10}, rects[1])
+		assert.Equal(t, treemapRect{}, rects[2])
+	})
+	t.Run("empty_rect", func(t *testing.T) {
+		rects := squarifyTreemap([]float64{1, 2}, treemapRect{w: 0, h: 10})
+		assert.Equal(t, []treemapRect{{}, {}}, rects)
+	})
+}
+
+func TestTreemapChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() TreemapChartOption
+	}{
+		{
+			name: "basic",
+			makeOptions: func() TreemapChartOption {
+				return NewTreemapChartOptionWithData(makeBasicTreemapNodes())
+			},
+		},
+		{
+			name: "title_legend_max_depth",
+			makeOptions: func() TreemapChartOption {
+				opt := NewTreemapChartOptionWithData(makeBasicTreemapNodes())
+				opt.Title = TitleOption{Text: "Cost by team"}
+				opt.Legend.Show = Ptr(true)
+				opt.MaxDepth = 1
+				return opt
+			},
+		},
+		{
+			name: "depth_padding_no_header",
+			makeOptions: func() TreemapChartOption {
+				opt := NewTreemapChartOptionWithData(makeBasicTreemapNodes())
+				opt.DepthPadding = []int{6, 1}
+				opt.ShowHeader = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "label_formatter",
+			makeOptions: func() TreemapChartOption {
+				opt := NewTreemapChartOptionWithData(makeBasicTreemapNodes())
+				opt.HeaderFontStyle = FontStyle{FontSize: 12, FontColor: ColorBlack}
+				opt.Label.LabelFormatter = func(index int, name string, val float64) (string, *LabelStyle) {
+					return name + " (" + strconv.Itoa(index) + ")", &LabelStyle{
+						FontStyle: FontStyle{FontColor: ColorWhite},
+					}
+				}
+				return opt
+			},
+		},
+		{
+			name: "hidden_labels_dark_theme",
+			makeOptions: func() TreemapChartOption {
+				opt := NewTreemapChartOptionWithData(makeBasicTreemapNodes())
+				opt.Theme = GetTheme(ThemeVividDark)
+				opt.Label.Show = Ptr(false)
+				return opt
+			},
+		},
+		{
+			name: "no_data",
+			makeOptions: func() TreemapChartOption {
+				return NewTreemapChartOptionWithData([]TreemapNode{{Name: "empty"}})
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.TreemapChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestTreemapChartError(t *testing.T) {
+	t.Parallel()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	})
+	err := p.TreemapChart(NewTreemapChartOptionWithData([]TreemapNode{
+		{Name: "a", Value: 4},
+		{Name: "b", Value: -2},
+	}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported negative value")
+}
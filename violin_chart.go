@@ -228,23 +228,38 @@ func gaussianKDE(samples []float64, pointCount int, bandwidthOverride *float64)
 		return nil
 	}
 
-	n := float64(len(samples))
-	bandwidth := 1.06 * summary.StandardDeviation * math.Pow(n, -0.2)
+	bandwidth := kdeBandwidth(summary, len(samples), bandwidthOverride)
+	if bandwidth == 0 {
+		return nil
+	}
+	return gaussianKDERange(samples, summary.Min, summary.Max, pointCount, bandwidth)
+}
+
+// kdeBandwidth returns the bandwidth override, or the bandwidth from Silverman's rule of thumb. Zero is returned
+// when the bandwidth is not valid.
+func kdeBandwidth(summary PopulationSummary, sampleCount int, bandwidthOverride *float64) float64 {
+	bandwidth := 1.06 * summary.StandardDeviation * math.Pow(float64(sampleCount), -0.2)
 	if bandwidthOverride != nil {
 		bandwidth = *bandwidthOverride
 	}
 	if bandwidth <= 0 || math.IsNaN(bandwidth) || math.IsInf(bandwidth, 0) {
-		return nil
+		return 0
 	}
+	return bandwidth
+}
 
+// gaussianKDERange returns the Gaussian kernel density of the samples at pointCount positions evenly spanning
+// minX to maxX.
+func gaussianKDERange(samples []float64, minX, maxX float64, pointCount int, bandwidth float64) []float64 {
+	n := float64(len(samples))
 	values := make([]float64, pointCount)
 	norm := 1.0 / (n * bandwidth * math.Sqrt(2*math.Pi))
 	for i := 0; i < pointCount; i++ {
 		var x float64
 		if pointCount > 1 {
-			x = summary.Min + (summary.Max-summary.Min)*(float64(i)/float64(pointCount-1))
+			x = minX + (maxX-minX)*(float64(i)/float64(pointCount-1))
 		} else {
-			x = (summary.Min + summary.Max) / 2.0
+			x = (minX + maxX) / 2.0
 		}
 
 		var sum float64
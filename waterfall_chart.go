@@ -0,0 +1,233 @@
+package charts
+
+import (
+	"slices"
+)
+
+type waterfallChart struct {
+	p   *Painter
+	opt *WaterfallChartOption
+}
+
+// newWaterfallChart returns a waterfall chart renderer.
+func newWaterfallChart(p *Painter, opt WaterfallChartOption) *waterfallChart {
+	return &waterfallChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+// NewWaterfallChartOptionWithData returns an initialized WaterfallChartOption with the SeriesList set with the
+// provided incremental change values. The totalIndexes mark the values rendered as subtotal or total bars.
+func NewWaterfallChartOptionWithData(data [][]float64, totalIndexes ...int) WaterfallChartOption {
+	return NewWaterfallChartOptionWithSeries(NewSeriesListWaterfall(data, WaterfallSeriesOption{
+		TotalIndexes: totalIndexes,
+	}))
+}
+
+// NewWaterfallChartOptionWithSeries returns an initialized WaterfallChartOption with the provided SeriesList.
+func NewWaterfallChartOptionWithSeries(sl WaterfallSeriesList) WaterfallChartOption {
+	return WaterfallChartOption{
+		SeriesList:     sl,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		ValueAxis:      make([]ValueAxisOption, getSeriesYAxisCount(sl)),
+		ValueFormatter: defaultValueFormatter,
+	}
+}
+
+// WaterfallChartOption defines the options for rendering a waterfall chart. Render the chart using
+// Painter.WaterfallChart.
+type WaterfallChartOption struct {
+	// Theme specifies the colors used for the waterfall chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// ValueAxis configures the value (numeric) axis. This axis represents the running totals.
+	ValueAxis []ValueAxisOption
+	// CategoryAxis configures the category axis. This axis represents the series group.
+	CategoryAxis CategoryAxisOption
+	// SeriesList provides the data population for the chart. Typically constructed using NewSeriesListWaterfall.
+	SeriesList WaterfallSeriesList
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the data legend.
+	Legend LegendOption
+	// BarSize sets each bar's width as a ratio of the slot space allotted to it (0.0–1.0, auto by default).
+	BarSize float64
+	// BarMargin sets the spacing between grouped bars as a ratio of the category slot
+	// (0.0–1.0, auto by default). BarSize takes priority over a set margin.
+	BarMargin *float64
+	// IncreaseColor overrides the color of bars with a positive change. Defaults to the theme "up" series color.
+	IncreaseColor Color
+	// DecreaseColor overrides the color of bars with a negative change. Defaults to the theme "down" series color.
+	DecreaseColor Color
+	// TotalColor overrides the color of subtotal and total bars. Defaults to the theme series color.
+	TotalColor Color
+	// ShowConnector when set to *false hides the lines connecting each bar to the running total of the next.
+	ShowConnector *bool
+	// ConnectorColor overrides the color of the connector lines. Defaults to the theme x-axis stroke color.
+	ConnectorColor Color
+	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
+	ValueFormatter ValueFormatter
+}
+
+func (w *waterfallChart) renderChart(result *defaultRenderResult) (Box, error) {
+	p := w.p
+	opt := w.opt
+	if len(opt.SeriesList) == 0 {
+		result.renderNoData(opt.Theme)
+		return p.box, nil
+	}
+	seriesCount := len(opt.SeriesList)
+	seriesPainter := result.seriesPainter
+
+	x0, x1 := result.categoryAxisRange.getRange(0)
+	width := int(x1 - x0)
+	barMaxHeight := seriesPainter.Height()
+	seriesNames := opt.SeriesList.names()
+	divideValues := result.categoryAxisRange.autoDivide()
+	margin, barMargin, barWidth := calculateGroupMarginsAndSize(seriesCount, width,
+		resolveBarSizePixels(opt.BarSize, width, seriesCount), resolveBarMarginPixels(opt.BarMargin, width))
+	showConnector := !flagIs(false, opt.ShowConnector)
+	connectorColor := opt.ConnectorColor
+	if connectorColor.IsZero() {
+		connectorColor = opt.Theme.GetXAxisStrokeColor()
+	}
+
+	markLinePainter := newMarkLinePainter(seriesPainter)
+	rendererList := []renderer{markLinePainter}
+
+	for index := range opt.SeriesList {
+		series := &opt.SeriesList[index]
+		yRange := result.valueAxisRanges[series.YAxisIndex]
+		seriesThemeIndex := index
+		if series.absThemeIndex != nil {
+			seriesThemeIndex = *series.absThemeIndex
+		}
+		increaseColor, decreaseColor := opt.Theme.GetSeriesUpDownColors(seriesThemeIndex)
+		if !opt.IncreaseColor.IsZero() {
+			increaseColor = opt.IncreaseColor
+		}
+		if !opt.DecreaseColor.IsZero() {
+			decreaseColor = opt.DecreaseColor
+		}
+		totalColor := opt.TotalColor
+		if totalColor.IsZero() {
+			totalColor = opt.Theme.GetSeriesColor(seriesThemeIndex)
+		}
+
+		var labelPainter *seriesLabelPainter
+		if flagIs(true, series.Label.Show) {
+			labelPainter = newSeriesLabelPainter(seriesPainter, seriesNames, series.Label, opt.Theme, opt.Padding.Right)
+			rendererList = append(rendererList, labelPainter)
+		}
+
+		runningTotals := series.runningTotals()
+		var priorTotal float64
+		connectorX := -1 // right edge of the prior bar, negative until a bar is drawn
+		for j, item := range series.Values {
+			if j >= result.categoryAxisRange.divideCount {
+				break
+			}
+			isTotal := series.isTotal(j)
+			if !isTotal && !isValidExtent(item) {
+				continue // skip null values, leaving a gap
+			}
+
+			start, end := priorTotal, runningTotals[j]
+			value := item
+			color := increaseColor
+			if isTotal {
+				start = 0
+				value = end
+				color = totalColor
+			} else if item < 0 {
+				color = decreaseColor
+			}
+			startY := barMaxHeight - yRange.getHeight(start)
+			endY := barMaxHeight - yRange.getHeight(end)
+			top, bottom := min(startY, endY), max(startY, endY)
+			if bottom == top {
+				bottom++ // keep zero changes visible as a thin bar
+			}
+			x := divideValues[j] + margin + index*(barWidth+barMargin)
+			seriesPainter.FilledRect(x, top, x+barWidth, bottom, color, color, 0.0)
+
+			if showConnector && connectorX >= 0 {
+				priorY := barMaxHeight - yRange.getHeight(priorTotal)
+				seriesPainter.LineStroke([]Point{{X: connectorX, Y: priorY}, {X: x, Y: priorY}}, connectorColor, 1)
+			}
+			connectorX = x + barWidth
+			priorTotal = end
+
+			if labelPainter != nil {
+				labelPainter.Add(labelValue{
+					vertical:  true,
+					index:     index,
+					dataIndex: j,
+					value:     value,
+					fontStyle: series.Label.FontStyle,
+					x:         x + (barWidth >> 1),
+					y:         top,
+					offset:    series.Label.Offset,
+				})
+			}
+		}
+
+		// global marks are not supported as there is no combined series to calculate against
+		if seriesMarks := series.MarkLine.Lines.filterGlobal(false); len(seriesMarks) > 0 {
+			markLineValueFormatter := getPreferredValueFormatter(series.MarkLine.ValueFormatter,
+				series.Label.ValueFormatter, opt.ValueFormatter)
+			markLinePainter.add(markLineRenderOption{
+				fillColor:      totalColor,
+				fontColor:      opt.Theme.GetMarkTextColor(),
+				strokeColor:    totalColor,
+				font:           series.Label.FontStyle.Font,
+				marklines:      seriesMarks,
+				seriesValues:   runningTotals,
+				axisRange:      yRange,
+				valueFormatter: markLineValueFormatter,
+			})
+		}
+	}
+
+	if err := doRender(rendererList...); err != nil {
+		return BoxZero, err
+	}
+	return p.box, nil
+}
+
+func (w *waterfallChart) Render() (Box, error) {
+	p := w.p
+	opt := w.opt
+	if opt.Theme == nil {
+		opt.Theme = getPreferredTheme(p.theme)
+	}
+	if opt.Legend.Symbol == "" {
+		// default to rectangle symbol for this chart type
+		opt.Legend.Symbol = SymbolSquare
+	}
+
+	valueAxis := slices.Clone(opt.ValueAxis) // cloned so normalization doesn't modify the caller's slice
+	if len(valueAxis) == 0 {
+		valueAxis = []ValueAxisOption{{}}
+	}
+	categoryAxis := opt.CategoryAxis
+	normalizeBarAxisPositions(false, &categoryAxis, valueAxis)
+
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		theme:          opt.Theme,
+		padding:        opt.Padding,
+		seriesList:     opt.SeriesList,
+		categoryAxis:   &categoryAxis,
+		valueAxis:      valueAxis,
+		title:          opt.Title,
+		legend:         &opt.Legend,
+		valueFormatter: opt.ValueFormatter,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	return w.renderChart(renderResult)
+}
@@ -0,0 +1,198 @@
+package charts
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicWaterfallChartOption() WaterfallChartOption {
+	opt := NewWaterfallChartOptionWithData([][]float64{
+		{420, 130, -80, 0, -150, 60, -35, 0},
+	}, 3, 7)
+	opt.Padding = NewBoxEqual(10)
+	opt.CategoryAxis.Labels = []string{"Sales", "Fees", "COGS", "Gross", "Opex", "Other", "Tax", "Net"}
+	return opt
+}
+
+func TestNewWaterfallChartOptionWithData(t *testing.T) {
+	t.Parallel()
+
+	opt := NewWaterfallChartOptionWithData([][]float64{{10, -5, 0}}, 2)
+
+	require.Len(t, opt.SeriesList, 1)
+	assert.Equal(t, ChartTypeWaterfall, opt.SeriesList[0].getType())
+	assert.Equal(t, []int{2}, opt.SeriesList[0].TotalIndexes)
+	assert.Equal(t, defaultPadding, opt.Padding)
+	assert.Len(t, opt.ValueAxis, 1)
+
+	p := NewPainter(PainterOptions{})
+	assert.NoError(t, p.WaterfallChart(opt))
+}
+
+func TestWaterfallSeriesRunningTotals(t *testing.T) {
+	t.Parallel()
+
+	s := WaterfallSeries{
+		Values:       []float64{100, -30, GetNullValue(), 999, 20, 0},
+		TotalIndexes: []int{3, 5},
+	}
+	assert.Equal(t, []float64{100, 70, 70, 70, 90, 90}, s.runningTotals())
+	assert.Equal(t, []float64{100, 70, 70, 70, 90, 90, 0}, s.getValues())
+}
+
+func TestWaterfallSeriesListToGenericSeriesList(t *testing.T) {
+	t.Parallel()
+
+	sl := NewSeriesListWaterfall([][]float64{{10, -4, 0}, {5, 5}}, WaterfallSeriesOption{
+		Names:        []string{"a", "b"},
+		TotalIndexes: []int{2},
+	})
+	generic := sl.ToGenericSeriesList()
+	require.Len(t, generic, 2)
+	assert.Equal(t, []float64{10, 0, -4, 0, 0, 1}, generic[0].Values)
+	assert.Equal(t, 3, generic.getSeriesLen(0))
+	assert.Equal(t, []float64{10, 6, 6, 0}, generic[0].getValues())
+
+	roundTrip := filterSeriesList[WaterfallSeriesList](generic, ChartTypeWaterfall)
+	require.Len(t, roundTrip, 2)
+	assert.Equal(t, []float64{10, -4, 0}, roundTrip[0].Values)
+	assert.Equal(t, []int{2}, roundTrip[0].TotalIndexes)
+	assert.Empty(t, roundTrip[1].TotalIndexes)
+	assert.Equal(t, "b", roundTrip[1].Name)
+	require.NotNil(t, roundTrip[1].absThemeIndex)
+	assert.Equal(t, 1, *roundTrip[1].absThemeIndex)
+}
+
+func TestWaterfallChart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		makeOptions func() WaterfallChartOption
+	}{
+		{
+			name:        "basic",
+			makeOptions: makeBasicWaterfallChartOption,
+		},
+		{
+			name: "labels_mark_line",
+			makeOptions: func() WaterfallChartOption {
+				opt := makeBasicWaterfallChartOption()
+				opt.Title = TitleOption{Text: "P&L Bridge"}
+				opt.Padding.Right = 30
+				opt.SeriesList[0].Label.Show = Ptr(true)
+				opt.SeriesList[0].MarkLine = NewMarkLine(SeriesMarkTypeMax)
+				return opt
+			},
+		},
+		{
+			name: "custom_colors_no_connector",
+			makeOptions: func() WaterfallChartOption {
+				opt := makeBasicWaterfallChartOption()
+				opt.IncreaseColor = ColorBlue
+				opt.DecreaseColor = ColorOrange
+				opt.TotalColor = ColorGray
+				opt.ShowConnector = Ptr(false)
+				opt.BarSize = 0.5
+				return opt
+			},
+		},
+		{
+			name: "connector_color",
+			makeOptions: func() WaterfallChartOption {
+				opt := makeBasicWaterfallChartOption()
+				opt.ConnectorColor = ColorRed
+				opt.Theme = GetTheme(ThemeVividDark)
+				return opt
+			},
+		},
+		{
+			name: "negative_totals",
+			makeOptions: func() WaterfallChartOption {
+				return NewWaterfallChartOptionWithData([][]float64{{20, -50, 10, 0}}, 3)
+			},
+		},
+		{
+			name: "multiple_series",
+			makeOptions: func() WaterfallChartOption {
+				opt := NewWaterfallChartOptionWithData([][]float64{
+					{20, -5, 10, 0},
+					{10, 15, -8, 0},
+				}, 3)
+				opt.SeriesList[0].Name = "2024"
+				opt.SeriesList[1].Name = "2025"
+				return opt
+			},
+		},
+		{
+			name: "null_gap",
+			makeOptions: func() WaterfallChartOption {
+				return NewWaterfallChartOptionWithData([][]float64{{20, GetNullValue(), 10, -5, 0}}, 4)
+			},
+		},
+		{
+			name: "empty_series",
+			makeOptions: func() WaterfallChartOption {
+				return NewWaterfallChartOptionWithSeries(WaterfallSeriesList{})
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{
+				OutputFormat: ChartOutputSVG,
+				Width:        600,
+				Height:       400,
+			})
+			require.NoError(t, p.WaterfallChart(tt.makeOptions()))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+		})
+	}
+}
+
+func TestWaterfallChartError(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicWaterfallChartOption()
+	opt.SeriesList[0].YAxisIndex = 2
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	})
+	err := p.WaterfallChart(opt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid y-axis index")
+}
+
+func TestWaterfallRender(t *testing.T) {
+	t.Parallel()
+
+	p, err := WaterfallRender([][]float64{{120, 40, -25, 0}}, []int{3},
+		SVGOutputOptionFunc(),
+		XAxisLabelsOptionFunc([]string{"Start", "Up", "Down", "End"}),
+	)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+}
+
+func TestRenderWaterfallCanNotMix(t *testing.T) {
+	t.Parallel()
+
+	waterfallSeries := NewSeriesListWaterfall([][]float64{{1, 2, 0}}).ToGenericSeriesList()
+	lineSeries := NewSeriesListLine([][]float64{{1, 2, 3}}).ToGenericSeriesList()
+	mixed := append(waterfallSeries, lineSeries...)
+
+	_, err := Render(ChartOption{SeriesList: mixed})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "waterfall can not mix other charts")
+}